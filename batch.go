@@ -0,0 +1,104 @@
+package itu
+
+import (
+	"iter"
+	"time"
+)
+
+// Clock is a source of timers for time-driven adapters such as [BatchClock].
+//
+// Implementations other than the system clock are mainly useful in tests,
+// where they let the caller decide when a deadline expires.
+type Clock interface {
+	// After returns a channel that receives the current time once d has
+	// elapsed, like time.After.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Batch returns a lazy iterator that groups the elements of seq into slices of
+// at most maxItems elements, emitting a partial batch once maxWait has passed
+// since its first element arrived.
+//
+// Batch is like [Chunk] for streams whose elements arrive at an uneven rate:
+// it bounds both the size of a batch and the time an element waits in it. It
+// uses the system clock; see [BatchClock] for details.
+//
+// Batch panics if maxItems or maxWait is not positive.
+func Batch[T any](seq iter.Seq[T], maxItems int, maxWait time.Duration) iter.Seq[[]T] {
+	if maxItems <= 0 {
+		panic("itu: Batch: maxItems must be positive")
+	}
+	if maxWait <= 0 {
+		panic("itu: Batch: maxWait must be positive")
+	}
+	return batch(seq, maxItems, maxWait, systemClock{})
+}
+
+// BatchClock is like [Batch] but takes its timers from clock.
+//
+// A batch is emitted as soon as it holds maxItems elements, or when the timer
+// started by its first element fires, whichever comes first. Empty batches are
+// never emitted; when seq ends, the remaining elements are emitted as a final
+// batch. Each yielded slice is newly allocated, so the consumer may keep it.
+//
+// seq is iterated on a separate goroutine so that the deadline can fire while
+// seq is waiting for its next element. When the consumer stops early,
+// BatchClock stops pulling from seq and waits for that goroutine to return
+// before the range loop ends. If seq panics, the panic is re-raised on the
+// consumer's goroutine.
+//
+// BatchClock panics if maxItems or maxWait is not positive, or if clock is nil.
+func BatchClock[T any](seq iter.Seq[T], maxItems int, maxWait time.Duration, clock Clock) iter.Seq[[]T] {
+	if maxItems <= 0 {
+		panic("itu: BatchClock: maxItems must be positive")
+	}
+	if maxWait <= 0 {
+		panic("itu: BatchClock: maxWait must be positive")
+	}
+	if clock == nil {
+		panic("itu: BatchClock clock is nil")
+	}
+	return batch(seq, maxItems, maxWait, clock)
+}
+
+func batch[T any](seq iter.Seq[T], maxItems int, maxWait time.Duration, clock Clock) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		src := startSource(seq, 0)
+		defer src.stop()
+
+		var items []T
+		var deadline <-chan time.Time
+		for {
+			select {
+			case v, ok := <-src.ch:
+				if !ok {
+					src.rethrow()
+					if len(items) > 0 {
+						yield(items)
+					}
+					return
+				}
+				if len(items) == 0 {
+					deadline = clock.After(maxWait)
+				}
+				items = append(items, v)
+				if len(items) < maxItems {
+					continue
+				}
+			case <-deadline:
+			}
+
+			if !yield(items) {
+				return
+			}
+			items = nil
+			deadline = nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package itu_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleBatch() {
+	// The source is fast, so every batch fills up before maxWait expires.
+	for b := range itu.Batch(itu.Range(0, 7), 3, time.Second) {
+		fmt.Println(b)
+	}
+	// Output:
+	// [0 1 2]
+	// [3 4 5]
+	// [6]
+}
+
+// tickClock is a Clock whose timers fire immediately.
+type tickClock struct{}
+
+func (tickClock) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func ExampleBatchClock() {
+	// With a clock whose deadlines expire at once, a slow source is flushed
+	// one element at a time.
+	slow := func(yield func(string) bool) {
+		for _, s := range []string{"a", "b"} {
+			time.Sleep(10 * time.Millisecond)
+			if !yield(s) {
+				return
+			}
+		}
+	}
+	for b := range itu.BatchClock(slow, 100, time.Minute, tickClock{}) {
+		fmt.Println(b)
+	}
+	// Output:
+	// [a]
+	// [b]
+}
@@ -0,0 +1,171 @@
+package itu
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+// manualClock hands out timers that fire only when the test sends on them.
+type manualClock struct {
+	timers chan chan time.Time
+}
+
+func newManualClock() *manualClock {
+	return &manualClock{timers: make(chan chan time.Time, 16)}
+}
+
+func (c *manualClock) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	c.timers <- ch
+	return ch
+}
+
+func TestBatchClock_SplitsBySize(t *testing.T) {
+	got := slices.Collect(BatchClock(Range(1, 6), 2, time.Hour, newManualClock()))
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BatchClock(1..5, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestBatchClock_Empty(t *testing.T) {
+	if got := slices.Collect(BatchClock(Empty[int](), 2, time.Hour, newManualClock())); len(got) != 0 {
+		t.Fatalf("BatchClock(empty) = %v, want empty", got)
+	}
+}
+
+func TestBatchClock_EmitsOnDeadline(t *testing.T) {
+	clock := newManualClock()
+	sentTwo := make(chan struct{})
+	gate := make(chan struct{})
+	seq := func(yield func(int) bool) {
+		if !yield(1) || !yield(2) {
+			return
+		}
+		close(sentTwo)
+		<-gate
+		yield(3)
+	}
+
+	batches := make(chan []int)
+	go func() {
+		defer close(batches)
+		for b := range BatchClock(seq, 10, time.Second, clock) {
+			batches <- b
+		}
+	}()
+
+	<-sentTwo
+	timer := <-clock.timers
+	timer <- time.Time{}
+	if got := <-batches; !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("BatchClock first batch = %v, want [1 2]", got)
+	}
+
+	close(gate)
+	if got := <-batches; !slices.Equal(got, []int{3}) {
+		t.Fatalf("BatchClock second batch = %v, want [3]", got)
+	}
+	if b, ok := <-batches; ok {
+		t.Fatalf("BatchClock yielded extra batch %v", b)
+	}
+}
+
+func TestBatchClock_StartsTimerPerBatch(t *testing.T) {
+	clock := newManualClock()
+	got := slices.Collect(BatchClock(Range(0, 6), 2, time.Second, clock))
+	if len(got) != 3 {
+		t.Fatalf("BatchClock(0..5, 2) = %v, want 3 batches", got)
+	}
+	if n := len(clock.timers); n != 3 {
+		t.Fatalf("BatchClock started %d timers, want 3", n)
+	}
+}
+
+func TestBatchClock_StopsSourceOnEarlyBreak(t *testing.T) {
+	produced := 0
+	finished := false
+	seq := func(yield func(int) bool) {
+		defer func() { finished = true }()
+		for i := 0; ; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	for range BatchClock(seq, 3, time.Hour, newManualClock()) {
+		break
+	}
+	if !finished {
+		t.Fatalf("BatchClock did not stop the source after break")
+	}
+	// One batch of 3, plus at most one value in flight when the consumer broke.
+	if produced > 4 {
+		t.Fatalf("BatchClock consumed %d values, want <= 4", produced)
+	}
+}
+
+func TestBatchClock_PropagatesSourcePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "bad source" {
+			t.Fatalf("BatchClock recovered %v, want %q", r, "bad source")
+		}
+	}()
+	seq := func(yield func(int) bool) {
+		yield(1)
+		panic("bad source")
+	}
+	for range BatchClock(seq, 3, time.Hour, newManualClock()) {
+	}
+	t.Fatalf("BatchClock did not panic")
+}
+
+func TestBatchClock_PanicsOnInvalidArgs(t *testing.T) {
+	cases := []struct {
+		name     string
+		maxItems int
+		maxWait  time.Duration
+		clock    Clock
+	}{
+		{"zero maxItems", 0, time.Second, newManualClock()},
+		{"zero maxWait", 1, 0, newManualClock()},
+		{"nil clock", 1, time.Second, nil},
+	}
+	for _, tc := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Fatalf("BatchClock(%s) did not panic, want panic", tc.name)
+				}
+			}()
+			_ = BatchClock(Of(1), tc.maxItems, tc.maxWait, tc.clock)
+		}()
+	}
+}
+
+func TestBatch_EmitsPartialBatchAfterMaxWait(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		if !yield(1) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+		yield(2)
+	}
+	got := slices.Collect(Batch(seq, 10, 5*time.Millisecond))
+	want := [][]int{{1}, {2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Batch(slow seq, 10, 5ms) = %v, want %v", got, want)
+	}
+}
+
+func TestBatch_PanicsOnNonPositiveMaxItems(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Batch(seq, 0, 1s) did not panic, want panic")
+		}
+	}()
+	_ = Batch(Of(1), 0, time.Second)
+}
@@ -0,0 +1,138 @@
+package itu
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// FromChan returns an iterator that yields the values received from ch until
+// ch is closed.
+//
+// Breaking out of the range loop stops receiving but does not close or drain
+// ch. Each iteration receives from the same channel, so values taken by one
+// iteration are not seen by another.
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToChan starts iterating seq on a new goroutine and returns a channel with
+// the given buffer size that receives its values.
+//
+// The channel is closed when seq ends or ctx is done, whichever happens first.
+// If ctx is done, ToChan stops pulling from seq; a value that was already
+// pulled but could not be delivered is dropped. To avoid leaking the
+// goroutine, the receiver must either drain the channel or cancel ctx.
+//
+// Because seq runs on its own goroutine, a panic in seq crashes the program.
+//
+// ToChan panics if buf is negative.
+func ToChan[T any](ctx context.Context, seq iter.Seq[T], buf int) <-chan T {
+	if buf < 0 {
+		panic("itu: ToChan: buf must be non-negative")
+	}
+	ch := make(chan T, buf)
+	go func() {
+		defer close(ch)
+		if ctx.Err() != nil {
+			return
+		}
+		for v := range seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// FanIn returns a lazy iterator that iterates all seqs concurrently, each on
+// its own goroutine, and yields their values as they arrive.
+//
+// Values from a single input keep their relative order, but values from
+// different inputs are interleaved in no particular order. The returned
+// iterator ends once every input has ended.
+//
+// When the consumer stops early, FanIn stops pulling from all inputs and waits
+// for their goroutines to return before the range loop ends. If an input
+// panics, FanIn stops the other inputs and re-raises the panic on the
+// consumer's goroutine.
+//
+// If seqs is empty, FanIn yields no values.
+func FanIn[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		out := make(chan T)
+		done := make(chan struct{})
+		var stopOnce sync.Once
+		cancel := func() { stopOnce.Do(func() { close(done) }) }
+
+		var (
+			mu       sync.Mutex
+			panicked bool
+			panicVal any
+		)
+
+		var wg sync.WaitGroup
+		for _, seq := range seqs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() {
+					if p := recover(); p != nil {
+						mu.Lock()
+						if !panicked {
+							panicked, panicVal = true, p
+						}
+						mu.Unlock()
+						cancel()
+					}
+				}()
+				for v := range seq {
+					select {
+					case out <- v:
+					case <-done:
+						return
+					}
+				}
+			}()
+		}
+
+		finished := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(out)
+			close(finished)
+		}()
+
+		stop := func() {
+			cancel()
+			<-finished
+		}
+		defer stop()
+
+		for v := range out {
+			mu.Lock()
+			p := panicked
+			mu.Unlock()
+			if p {
+				break
+			}
+			if !yield(v) {
+				return
+			}
+		}
+
+		stop()
+		if panicked {
+			panic(panicVal)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package itu_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleFromChan() {
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		for _, s := range []string{"a", "bb", "ccc"} {
+			ch <- s
+		}
+	}()
+
+	lengths := itu.Map(itu.FromChan(ch), func(s string) int { return len(s) })
+	fmt.Println(slices.Collect(lengths))
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleToChan() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for v := range itu.ToChan(ctx, itu.Range(0, 3), 1) {
+		fmt.Println(v)
+	}
+	// Output:
+	// 0
+	// 1
+	// 2
+}
+
+func ExampleFanIn() {
+	a := itu.Of(1, 3, 5)
+	b := itu.Of(2, 4, 6)
+	// Values arrive interleaved in no particular order; sort them for a
+	// stable output.
+	fmt.Println(slices.Sorted(itu.FanIn(a, b)))
+	// Output:
+	// [1 2 3 4 5 6]
+}
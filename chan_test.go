@@ -0,0 +1,178 @@
+package itu
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFromChan_YieldsUntilClosed(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	got := slices.Collect(FromChan(ch))
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("FromChan = %v, want [1 2 3]", got)
+	}
+}
+
+func TestFromChan_EarlyBreakLeavesRemainingValues(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	for range FromChan(ch) {
+		break
+	}
+	got := slices.Collect(FromChan(ch))
+	if !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("FromChan after break = %v, want [2 3]", got)
+	}
+}
+
+func TestToChan_DeliversAllAndCloses(t *testing.T) {
+	ch := ToChan(context.Background(), Range(0, 5), 2)
+	var got []int
+	for v := range ch {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("ToChan = %v, want [0 1 2 3 4]", got)
+	}
+}
+
+func TestToChan_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	seq := func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	ch := ToChan(ctx, seq, 0)
+	if v := <-ch; v != 0 {
+		t.Fatalf("ToChan first value = %d, want 0", v)
+	}
+	cancel()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("ToChan did not stop the sequence after cancel")
+	}
+	// The channel must be closed; at most one in-flight value may remain.
+	n := 0
+	for range ch {
+		n++
+	}
+	if n > 1 {
+		t.Fatalf("ToChan delivered %d values after cancel, want <= 1", n)
+	}
+}
+
+func TestToChan_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	produced := 0
+	seq := func(yield func(int) bool) {
+		produced++
+		yield(1)
+	}
+	for range ToChan(ctx, seq, 1) {
+		t.Fatalf("ToChan(cancelled) delivered a value")
+	}
+	if produced != 0 {
+		t.Fatalf("ToChan(cancelled) consumed %d values, want 0", produced)
+	}
+}
+
+func TestToChan_PanicsOnNegativeBuf(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("ToChan(ctx, seq, -1) did not panic, want panic")
+		}
+	}()
+	_ = ToChan(context.Background(), Of(1), -1)
+}
+
+func TestFromChanToChan_RoundTrip(t *testing.T) {
+	got := slices.Collect(FromChan(ToChan(context.Background(), Of("a", "b", "c"), 1)))
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Fatalf("FromChan(ToChan) = %v, want [a b c]", got)
+	}
+}
+
+func TestFanIn_YieldsAllValues(t *testing.T) {
+	got := slices.Sorted(FanIn(Range(0, 10), Range(10, 20), Empty[int](), Range(20, 25)))
+	want := slices.Collect(Range(0, 25))
+	if !slices.Equal(got, want) {
+		t.Fatalf("sorted FanIn = %v, want %v", got, want)
+	}
+}
+
+func TestFanIn_KeepsPerInputOrder(t *testing.T) {
+	var a, b []int
+	for v := range FanIn(Range(0, 100), Range(1000, 1100)) {
+		if v < 1000 {
+			a = append(a, v)
+		} else {
+			b = append(b, v)
+		}
+	}
+	if !slices.IsSorted(a) || !slices.IsSorted(b) {
+		t.Fatalf("FanIn reordered values of a single input")
+	}
+}
+
+func TestFanIn_NoInputs(t *testing.T) {
+	if got := slices.Collect(FanIn[int]()); len(got) != 0 {
+		t.Fatalf("FanIn() = %v, want empty", got)
+	}
+}
+
+func TestFanIn_EarlyBreakStopsAllInputs(t *testing.T) {
+	var running atomic.Int32
+	infinite := func(yield func(int) bool) {
+		running.Add(1)
+		defer running.Add(-1)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	n := 0
+	for range FanIn(infinite, infinite, infinite) {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	if r := running.Load(); r != 0 {
+		t.Fatalf("FanIn left %d inputs running after break, want 0", r)
+	}
+}
+
+func TestFanIn_PropagatesPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "bad input" {
+			t.Fatalf("FanIn recovered %v, want %q", r, "bad input")
+		}
+	}()
+	bad := func(yield func(int) bool) {
+		panic("bad input")
+	}
+	for range FanIn(RangeFrom(0), bad) {
+	}
+	t.Fatalf("FanIn did not panic")
+}
@@ -0,0 +1,218 @@
+package itu
+
+import (
+	"iter"
+	"slices"
+)
+
+// Chunk returns a lazy iterator that yields consecutive batches of n elements
+// from seq.
+//
+// For example, for seq producing [1 2 3 4 5] and n=2, Chunk yields [1 2], [3 4]
+// and [5]. The last batch holds the remaining elements and may be shorter than
+// n. Each yielded slice is newly allocated, so the consumer may keep it.
+//
+// Values are produced only as the returned iterator is consumed.
+//
+// Chunk panics if n is not positive.
+func Chunk[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n <= 0 {
+		panic("itu: Chunk: n must be positive")
+	}
+	return func(yield func([]T) bool) {
+		var chunk []T
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+				chunk = nil
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Chunk2 returns a lazy iterator that yields consecutive batches of n pairs
+// from seq, split into a slice of keys and a slice of values.
+//
+// The last batch holds the remaining pairs and may be shorter than n. Each
+// yielded slice is newly allocated, so the consumer may keep it.
+//
+// Pairs are produced only as the returned iterator is consumed.
+//
+// Chunk2 panics if n is not positive.
+func Chunk2[K, V any](seq iter.Seq2[K, V], n int) iter.Seq2[[]K, []V] {
+	if n <= 0 {
+		panic("itu: Chunk2: n must be positive")
+	}
+	return func(yield func([]K, []V) bool) {
+		var keys []K
+		var values []V
+		for k, v := range seq {
+			keys = append(keys, k)
+			values = append(values, v)
+			if len(keys) == n {
+				if !yield(keys, values) {
+					return
+				}
+				keys, values = nil, nil
+			}
+		}
+		if len(keys) > 0 {
+			yield(keys, values)
+		}
+	}
+}
+
+// Windows returns a lazy iterator that yields sliding windows of size elements
+// from seq, starting a new window every step elements.
+//
+// For example, for seq producing [1 2 3 4 5], size=3 and step=1, Windows yields
+// [1 2 3], [2 3 4] and [3 4 5]. If step > size, the elements between windows
+// are skipped. Only complete windows are yielded: if seq produces fewer than
+// size elements, Windows yields nothing. Each yielded slice is newly allocated,
+// so the consumer may keep it.
+//
+// Values are produced only as the returned iterator is consumed.
+//
+// Windows panics if size or step is not positive.
+func Windows[T any](seq iter.Seq[T], size, step int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic("itu: Windows: size must be positive")
+	}
+	if step <= 0 {
+		panic("itu: Windows: step must be positive")
+	}
+	return func(yield func([]T) bool) {
+		var buf []T
+		skip := 0
+		for v := range seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			buf = append(buf, v)
+			if len(buf) < size {
+				continue
+			}
+			if !yield(slices.Clone(buf)) {
+				return
+			}
+			if step >= size {
+				buf = buf[:0]
+				skip = step - size
+			} else {
+				buf = buf[:copy(buf, buf[step:])]
+			}
+		}
+	}
+}
+
+// Windows2 returns a lazy iterator that yields sliding windows of size pairs
+// from seq, starting a new window every step pairs. Each window is split into a
+// slice of keys and a slice of values.
+//
+// Only complete windows are yielded. Each yielded slice is newly allocated, so
+// the consumer may keep it.
+//
+// Pairs are produced only as the returned iterator is consumed.
+//
+// Windows2 panics if size or step is not positive.
+func Windows2[K, V any](seq iter.Seq2[K, V], size, step int) iter.Seq2[[]K, []V] {
+	if size <= 0 {
+		panic("itu: Windows2: size must be positive")
+	}
+	if step <= 0 {
+		panic("itu: Windows2: step must be positive")
+	}
+	return func(yield func([]K, []V) bool) {
+		var keys []K
+		var values []V
+		skip := 0
+		for k, v := range seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			keys = append(keys, k)
+			values = append(values, v)
+			if len(keys) < size {
+				continue
+			}
+			if !yield(slices.Clone(keys), slices.Clone(values)) {
+				return
+			}
+			if step >= size {
+				keys, values = keys[:0], values[:0]
+				skip = step - size
+			} else {
+				keys = keys[:copy(keys, keys[step:])]
+				values = values[:copy(values, values[step:])]
+			}
+		}
+	}
+}
+
+// ChunkBy returns a lazy iterator that groups consecutive elements of seq with
+// equal keys, as computed by keyFn, and yields each group as a slice.
+//
+// For example, for seq producing [1 1 2 3 3 1] and the identity key, ChunkBy
+// yields [1 1], [2], [3 3] and [1]. Only adjacent elements are grouped. Each
+// yielded slice is newly allocated, so the consumer may keep it.
+//
+// Values are produced only as the returned iterator is consumed.
+func ChunkBy[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		var chunk []T
+		var key K
+		for v := range seq {
+			k := keyFn(v)
+			if len(chunk) > 0 && k != key {
+				if !yield(chunk) {
+					return
+				}
+				chunk = nil
+			}
+			key = k
+			chunk = append(chunk, v)
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// ChunkBy2 returns a lazy iterator that groups consecutive pairs of seq with
+// equal keys, as computed by keyFn, and yields each group split into a slice of
+// keys and a slice of values.
+//
+// Only adjacent pairs are grouped. Each yielded slice is newly allocated, so
+// the consumer may keep it.
+//
+// Pairs are produced only as the returned iterator is consumed.
+func ChunkBy2[K, V any, G comparable](seq iter.Seq2[K, V], keyFn func(K, V) G) iter.Seq2[[]K, []V] {
+	return func(yield func([]K, []V) bool) {
+		var keys []K
+		var values []V
+		var group G
+		for k, v := range seq {
+			g := keyFn(k, v)
+			if len(keys) > 0 && g != group {
+				if !yield(keys, values) {
+					return
+				}
+				keys, values = nil, nil
+			}
+			group = g
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+		if len(keys) > 0 {
+			yield(keys, values)
+		}
+	}
+}
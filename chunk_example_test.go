@@ -0,0 +1,75 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleChunk() {
+	for batch := range itu.Chunk(itu.Range(1, 8), 3) {
+		fmt.Println(batch)
+	}
+	// Output:
+	// [1 2 3]
+	// [4 5 6]
+	// [7]
+}
+
+func ExampleChunk2() {
+	// slices.All returns an iter.Seq2 over index/value pairs.
+	all := slices.All([]string{"a", "b", "c"})
+	for idx, vals := range itu.Chunk2(all, 2) {
+		fmt.Println(idx, vals)
+	}
+	// Output:
+	// [0 1] [a b]
+	// [2] [c]
+}
+
+func ExampleWindows() {
+	for w := range itu.Windows(itu.Range(1, 6), 3, 1) {
+		fmt.Println(w)
+	}
+	// Output:
+	// [1 2 3]
+	// [2 3 4]
+	// [3 4 5]
+}
+
+func ExampleWindows2() {
+	// slices.All returns an iter.Seq2 over index/value pairs.
+	all := slices.All([]string{"a", "b", "c", "d"})
+	for idx, vals := range itu.Windows2(all, 2, 2) {
+		fmt.Println(idx, vals)
+	}
+	// Output:
+	// [0 1] [a b]
+	// [2 3] [c d]
+}
+
+func ExampleChunkBy() {
+	words := itu.Of("apple", "avocado", "banana", "blueberry", "cherry")
+	for group := range itu.ChunkBy(words, func(s string) byte { return s[0] }) {
+		fmt.Println(strings.Join(group, ","))
+	}
+	// Output:
+	// apple,avocado
+	// banana,blueberry
+	// cherry
+}
+
+func ExampleChunkBy2() {
+	// slices.All returns an iter.Seq2 over index/value pairs.
+	all := slices.All([]int{1, 3, 2, 4, 5})
+	odd := func(_ int, v int) bool { return v%2 == 1 }
+	for idx, vals := range itu.ChunkBy2(all, odd) {
+		fmt.Println(idx, vals)
+	}
+	// Output:
+	// [0 1] [1 3]
+	// [2 3] [2 4]
+	// [4] [5]
+}
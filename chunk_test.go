@@ -0,0 +1,191 @@
+package itu
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestChunk_SplitsWithRemainder(t *testing.T) {
+	got := slices.Collect(Chunk(Of(1, 2, 3, 4, 5), 2))
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Chunk([1 2 3 4 5], 2) = %v, want %v", got, want)
+	}
+}
+
+func TestChunk_ExactMultiple(t *testing.T) {
+	got := slices.Collect(Chunk(Of(1, 2, 3, 4), 2))
+	want := [][]int{{1, 2}, {3, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Chunk([1 2 3 4], 2) = %v, want %v", got, want)
+	}
+}
+
+func TestChunk_Empty(t *testing.T) {
+	if got := slices.Collect(Chunk(Empty[int](), 3)); len(got) != 0 {
+		t.Fatalf("Chunk(empty, 3) = %v, want empty", got)
+	}
+}
+
+func TestChunk_ChunksAreIndependent(t *testing.T) {
+	chunks := slices.Collect(Chunk(Of(1, 2, 3, 4), 2))
+	chunks[0][0] = 100
+	if chunks[1][0] != 3 {
+		t.Fatalf("Chunk reused backing storage: %v", chunks)
+	}
+}
+
+func TestChunk_DoesNotOverconsume(t *testing.T) {
+	produced := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; i < 10; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	for range Chunk(seq, 3) {
+		break
+	}
+	if produced != 3 {
+		t.Fatalf("Chunk(seq, 3) consumed %d values for one chunk, want 3", produced)
+	}
+}
+
+func TestChunk_PanicsOnNonPositiveN(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Chunk(seq, 0) did not panic, want panic")
+		}
+	}()
+	_ = Chunk(Of(1), 0)
+}
+
+func TestChunk2_SplitsPairs(t *testing.T) {
+	var got []string
+	for ks, vs := range Chunk2(slices.All([]string{"a", "b", "c"}), 2) {
+		got = append(got, fmt.Sprintf("%v:%v", ks, vs))
+	}
+	want := []string{"[0 1]:[a b]", "[2]:[c]"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Chunk2([a b c], 2) = %v, want %v", got, want)
+	}
+}
+
+func TestChunk2_PanicsOnNonPositiveN(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Chunk2(seq, -1) did not panic, want panic")
+		}
+	}()
+	_ = Chunk2(slices.All([]int{1}), -1)
+}
+
+func TestWindows_Overlapping(t *testing.T) {
+	got := slices.Collect(Windows(Of(1, 2, 3, 4, 5), 3, 1))
+	want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Windows([1 2 3 4 5], 3, 1) = %v, want %v", got, want)
+	}
+}
+
+func TestWindows_StepEqualsSize(t *testing.T) {
+	got := slices.Collect(Windows(Of(1, 2, 3, 4, 5), 2, 2))
+	want := [][]int{{1, 2}, {3, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Windows([1 2 3 4 5], 2, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestWindows_StepGreaterThanSize(t *testing.T) {
+	got := slices.Collect(Windows(Range(0, 10), 2, 3))
+	want := [][]int{{0, 1}, {3, 4}, {6, 7}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Windows(0..9, 2, 3) = %v, want %v", got, want)
+	}
+}
+
+func TestWindows_ShorterThanSize(t *testing.T) {
+	if got := slices.Collect(Windows(Of(1, 2), 3, 1)); len(got) != 0 {
+		t.Fatalf("Windows([1 2], 3, 1) = %v, want empty", got)
+	}
+}
+
+func TestWindows_WindowsAreIndependent(t *testing.T) {
+	windows := slices.Collect(Windows(Of(1, 2, 3), 2, 1))
+	windows[0][1] = 100
+	if windows[1][0] != 2 {
+		t.Fatalf("Windows reused backing storage: %v", windows)
+	}
+}
+
+func TestWindows_PanicsOnInvalidArgs(t *testing.T) {
+	for _, args := range [][2]int{{0, 1}, {1, 0}, {-1, 1}, {1, -1}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Fatalf("Windows(seq, %d, %d) did not panic, want panic", args[0], args[1])
+				}
+			}()
+			_ = Windows(Of(1), args[0], args[1])
+		}()
+	}
+}
+
+func TestWindows2_Overlapping(t *testing.T) {
+	var got []string
+	for ks, vs := range Windows2(slices.All([]string{"a", "b", "c"}), 2, 1) {
+		got = append(got, fmt.Sprintf("%v:%v", ks, vs))
+	}
+	want := []string{"[0 1]:[a b]", "[1 2]:[b c]"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Windows2([a b c], 2, 1) = %v, want %v", got, want)
+	}
+}
+
+func TestWindows2_PanicsOnInvalidStep(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Windows2(seq, 1, 0) did not panic, want panic")
+		}
+	}()
+	_ = Windows2(slices.All([]int{1}), 1, 0)
+}
+
+func TestChunkBy_GroupsAdjacent(t *testing.T) {
+	got := slices.Collect(ChunkBy(Of(1, 1, 2, 3, 3, 1), func(v int) int { return v }))
+	want := [][]int{{1, 1}, {2}, {3, 3}, {1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ChunkBy([1 1 2 3 3 1]) = %v, want %v", got, want)
+	}
+}
+
+func TestChunkBy_Empty(t *testing.T) {
+	if got := slices.Collect(ChunkBy(Empty[int](), func(v int) int { return v })); len(got) != 0 {
+		t.Fatalf("ChunkBy(empty) = %v, want empty", got)
+	}
+}
+
+func TestChunkBy_ZeroKeyFirst(t *testing.T) {
+	// The first group must not be merged with an implicit zero key.
+	got := slices.Collect(ChunkBy(Of(0, 0, 1), func(v int) int { return v }))
+	want := [][]int{{0, 0}, {1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ChunkBy([0 0 1]) = %v, want %v", got, want)
+	}
+}
+
+func TestChunkBy2_GroupsAdjacent(t *testing.T) {
+	var got []string
+	seq := slices.All([]string{"apple", "avocado", "banana", "cherry", "cranberry"})
+	for ks, vs := range ChunkBy2(seq, func(_ int, v string) byte { return v[0] }) {
+		got = append(got, fmt.Sprintf("%v:%v", ks, vs))
+	}
+	want := []string{"[0 1]:[apple avocado]", "[2]:[banana]", "[3 4]:[cherry cranberry]"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("ChunkBy2(first letter) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,166 @@
+package itu
+
+import (
+	"context"
+	"iter"
+)
+
+// WithContext returns a fallible iterator that yields (v, nil) for each element
+// v in seq until ctx is done.
+//
+// The context is checked before iteration starts and before each value is
+// yielded. Once ctx is done, WithContext yields (zero, ctx.Err()) and stops, so
+// the result composes with the *Err adapters such as [MapErr] and
+// [CollectErr].
+//
+// Cancellation is only observed between values: if seq blocks while producing
+// a value, WithContext cannot interrupt it.
+func WithContext[T any](ctx context.Context, seq iter.Seq[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if err := ctx.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for v := range seq {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// FoldCtx folds seq from left to right like [Fold], checking ctx before each
+// element.
+//
+// If ctx is done before seq ends, FoldCtx stops consuming seq and returns the
+// accumulator built so far together with ctx.Err().
+func FoldCtx[T, R any](ctx context.Context, seq iter.Seq[T], acc R, fn func(R, T) R) (R, error) {
+	if err := ctx.Err(); err != nil {
+		return acc, err
+	}
+	for v := range seq {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		acc = fn(acc, v)
+	}
+	return acc, nil
+}
+
+// ReduceCtx reduces seq from left to right like [Reduce], checking ctx before
+// each element.
+//
+// If seq is empty, ReduceCtx returns the zero value of T, ok=false and a nil
+// error. If ctx is done before seq ends, ReduceCtx stops consuming seq and
+// returns the partial result together with ctx.Err().
+func ReduceCtx[T any](ctx context.Context, seq iter.Seq[T], fn func(T, T) T) (result T, ok bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return result, false, err
+	}
+	for v := range seq {
+		if err := ctx.Err(); err != nil {
+			return result, ok, err
+		}
+		if !ok {
+			result = v
+			ok = true
+			continue
+		}
+		result = fn(result, v)
+	}
+	return result, ok, nil
+}
+
+// CountCtx counts the elements of seq like [Count], checking ctx before each
+// element.
+//
+// If ctx is done before seq ends, CountCtx stops consuming seq and returns the
+// number of elements seen so far together with ctx.Err(). If the result
+// overflows, CountCtx panics.
+func CountCtx[T any](ctx context.Context, seq iter.Seq[T]) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	n := 0
+	for range seq {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		next := n + 1
+		if next <= n {
+			panic("itu: CountCtx: overflow (sequence may be infinite or too large)")
+		}
+		n = next
+	}
+	return n, nil
+}
+
+// FindCtx searches for an element of seq that satisfies pred like [Find],
+// checking ctx before each element.
+//
+// If ctx is done before a match is found, FindCtx stops consuming seq and
+// returns the zero value of T, ok=false and ctx.Err().
+func FindCtx[T any](ctx context.Context, seq iter.Seq[T], pred func(T) bool) (value T, ok bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return value, false, err
+	}
+	for v := range seq {
+		if err := ctx.Err(); err != nil {
+			return value, false, err
+		}
+		if pred(v) {
+			return v, true, nil
+		}
+	}
+	return value, false, nil
+}
+
+// LastCtx returns the last element produced by seq like [Last], checking ctx
+// before each element.
+//
+// If ctx is done before seq ends, LastCtx stops consuming seq and returns the
+// last element seen so far together with ctx.Err().
+func LastCtx[T any](ctx context.Context, seq iter.Seq[T]) (value T, ok bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return value, false, err
+	}
+	for v := range seq {
+		if err := ctx.Err(); err != nil {
+			return value, ok, err
+		}
+		value = v
+		ok = true
+	}
+	return value, ok, nil
+}
+
+// NthCtx returns the element of seq at the zero-based index n like [Nth],
+// checking ctx before each element.
+//
+// If ctx is done before the n-th element is reached, NthCtx stops consuming seq
+// and returns the zero value of T, ok=false and ctx.Err().
+func NthCtx[T any](ctx context.Context, seq iter.Seq[T], n int) (value T, ok bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return value, false, err
+	}
+	if n < 0 {
+		return value, false, nil
+	}
+	idx := 0
+	for v := range seq {
+		if err := ctx.Err(); err != nil {
+			return value, false, err
+		}
+		if idx == n {
+			return v, true, nil
+		}
+		idx++
+	}
+	return value, false, nil
+}
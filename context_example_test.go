@@ -0,0 +1,79 @@
+package itu_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for v, err := range itu.WithContext(ctx, itu.Cycle(itu.Of("a", "b"))) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(v)
+		if v == "b" {
+			cancel()
+		}
+	}
+	// Output:
+	// a
+	// b
+	// context canceled
+}
+
+func ExampleFoldCtx() {
+	sum, err := itu.FoldCtx(context.Background(), itu.Range(1, 5), 0, func(acc, v int) int {
+		return acc + v
+	})
+	fmt.Println(sum, err)
+	// Output:
+	// 10 <nil>
+}
+
+func ExampleReduceCtx() {
+	product, ok, err := itu.ReduceCtx(context.Background(), itu.Range(1, 5), func(a, b int) int {
+		return a * b
+	})
+	fmt.Println(product, ok, err)
+	// Output:
+	// 24 true <nil>
+}
+
+func ExampleCountCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	n, err := itu.CountCtx(ctx, itu.RangeFrom(0))
+	fmt.Println(n, err)
+	// Output:
+	// 0 context canceled
+}
+
+func ExampleFindCtx() {
+	v, ok, err := itu.FindCtx(context.Background(), itu.RangeFrom(1), func(v int) bool {
+		return v*v > 50
+	})
+	fmt.Println(v, ok, err)
+	// Output:
+	// 8 true <nil>
+}
+
+func ExampleLastCtx() {
+	v, ok, err := itu.LastCtx(context.Background(), itu.Of("x", "y", "z"))
+	fmt.Println(v, ok, err)
+	// Output:
+	// z true <nil>
+}
+
+func ExampleNthCtx() {
+	v, ok, err := itu.NthCtx(context.Background(), itu.RangeFrom(100), 5)
+	fmt.Println(v, ok, err)
+	// Output:
+	// 105 true <nil>
+}
@@ -0,0 +1,177 @@
+package itu
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestWithContext_YieldsUntilCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []int
+	var gotErr error
+	for v, err := range WithContext(ctx, RangeFrom(0)) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, v)
+		if v == 2 {
+			cancel()
+		}
+	}
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("WithContext values = %v, want [0 1 2]", got)
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Fatalf("WithContext error = %v, want %v", gotErr, context.Canceled)
+	}
+}
+
+func TestWithContext_AlreadyCancelledDoesNotConsume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	produced := 0
+	seq := func(yield func(int) bool) {
+		produced++
+		yield(1)
+	}
+	got, err := CollectErr(WithContext(ctx, seq))
+	if len(got) != 0 || !errors.Is(err, context.Canceled) {
+		t.Fatalf("WithContext(cancelled) = (%v, %v), want ([], %v)", got, err, context.Canceled)
+	}
+	if produced != 0 {
+		t.Fatalf("WithContext(cancelled) consumed %d values, want 0", produced)
+	}
+}
+
+func TestWithContext_NoErrorWhenSeqEnds(t *testing.T) {
+	got, err := CollectErr(WithContext(context.Background(), Of(1, 2, 3)))
+	if err != nil || !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("WithContext = (%v, %v), want ([1 2 3], nil)", got, err)
+	}
+}
+
+func TestFoldCtx_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := FoldCtx(ctx, Cycle(Of(1)), 0, func(acc, v int) int {
+		if acc == 4 {
+			cancel()
+		}
+		return acc + v
+	})
+	if got != 5 || !errors.Is(err, context.Canceled) {
+		t.Fatalf("FoldCtx = (%d, %v), want (5, %v)", got, err, context.Canceled)
+	}
+}
+
+func TestFoldCtx_Completes(t *testing.T) {
+	got, err := FoldCtx(context.Background(), Of(1, 2, 3), 0, func(acc, v int) int { return acc + v })
+	if got != 6 || err != nil {
+		t.Fatalf("FoldCtx = (%d, %v), want (6, nil)", got, err)
+	}
+}
+
+func TestReduceCtx(t *testing.T) {
+	got, ok, err := ReduceCtx(context.Background(), Of(1, 2, 3), func(a, b int) int { return a + b })
+	if got != 6 || !ok || err != nil {
+		t.Fatalf("ReduceCtx = (%d, %v, %v), want (6, true, nil)", got, ok, err)
+	}
+
+	_, ok, err = ReduceCtx(context.Background(), Empty[int](), func(a, b int) int { return a + b })
+	if ok || err != nil {
+		t.Fatalf("ReduceCtx(empty) = (_, %v, %v), want (_, false, nil)", ok, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, ok, err = ReduceCtx(ctx, Of(1, 2), func(a, b int) int { return a + b })
+	if ok || !errors.Is(err, context.Canceled) {
+		t.Fatalf("ReduceCtx(cancelled) = (_, %v, %v), want (_, false, %v)", ok, err, context.Canceled)
+	}
+}
+
+func TestCountCtx_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	seq := Map(RangeFrom(0), func(v int) int {
+		if v == 10 {
+			cancel()
+		}
+		return v
+	})
+	got, err := CountCtx(ctx, seq)
+	if got != 10 || !errors.Is(err, context.Canceled) {
+		t.Fatalf("CountCtx = (%d, %v), want (10, %v)", got, err, context.Canceled)
+	}
+}
+
+func TestFindCtx(t *testing.T) {
+	got, ok, err := FindCtx(context.Background(), RangeFrom(0), func(v int) bool { return v == 7 })
+	if got != 7 || !ok || err != nil {
+		t.Fatalf("FindCtx = (%d, %v, %v), want (7, true, nil)", got, ok, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	_, ok, err = FindCtx(ctx, Cycle(Of(1, 2, 3)), func(int) bool {
+		calls++
+		if calls == 5 {
+			cancel()
+		}
+		return false
+	})
+	if ok || !errors.Is(err, context.Canceled) {
+		t.Fatalf("FindCtx(cancelled) = (_, %v, %v), want (_, false, %v)", ok, err, context.Canceled)
+	}
+	if calls != 5 {
+		t.Fatalf("FindCtx(cancelled) called pred %d times, want 5", calls)
+	}
+}
+
+func TestLastCtx(t *testing.T) {
+	got, ok, err := LastCtx(context.Background(), Of(1, 2, 3))
+	if got != 3 || !ok || err != nil {
+		t.Fatalf("LastCtx = (%d, %v, %v), want (3, true, nil)", got, ok, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	seq := Map(RangeFrom(0), func(v int) int {
+		if v == 3 {
+			cancel()
+		}
+		return v
+	})
+	got, ok, err = LastCtx(ctx, seq)
+	if got != 2 || !ok || !errors.Is(err, context.Canceled) {
+		t.Fatalf("LastCtx(cancelled) = (%d, %v, %v), want (2, true, %v)", got, ok, err, context.Canceled)
+	}
+}
+
+func TestNthCtx(t *testing.T) {
+	got, ok, err := NthCtx(context.Background(), RangeFrom(10), 3)
+	if got != 13 || !ok || err != nil {
+		t.Fatalf("NthCtx = (%d, %v, %v), want (13, true, nil)", got, ok, err)
+	}
+
+	_, ok, err = NthCtx(context.Background(), Of(1), -1)
+	if ok || err != nil {
+		t.Fatalf("NthCtx(-1) = (_, %v, %v), want (_, false, nil)", ok, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, ok, err = NthCtx(ctx, RangeFrom(0), 100)
+	if ok || !errors.Is(err, context.Canceled) {
+		t.Fatalf("NthCtx(cancelled) = (_, %v, %v), want (_, false, %v)", ok, err, context.Canceled)
+	}
+}
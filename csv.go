@@ -0,0 +1,318 @@
+package itu
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// The CSV functions take a *csv.Reader or *csv.Writer rather than an io.Reader
+// or io.Writer, so that the caller controls the dialect. For TSV, set Comma to
+// '\t' (and usually LazyQuotes to true) before passing the reader.
+
+// CSVRows returns a fallible iterator over the records read from r.
+//
+// Each record is yielded as returned by r.Read; if r.ReuseRecord is set, the
+// slice is only valid until the next iteration step. Reading stops at io.EOF.
+// Any other error, including a *csv.ParseError, is yielded as (nil, err) and
+// ends the iteration.
+//
+// The sequence is single-pass: iterating it again continues reading from r.
+func CSVRows(r *csv.Reader) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		for {
+			rec, err := r.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(rec, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CSVMaps returns a fallible iterator over the records read from r, each
+// converted to a map from column name to value.
+//
+// The first record is read as the header and is not yielded. Columns that have
+// no header are ignored; header columns that a record lacks are absent from its
+// map. If the input is empty, CSVMaps yields nothing.
+//
+// Errors are handled as in [CSVRows]. The sequence is single-pass.
+func CSVMaps(r *csv.Reader) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		header, ok := readCSVHeader(r, func(err error) { yield(nil, err) })
+		if !ok {
+			return
+		}
+
+		for rec, err := range CSVRows(r) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			m := make(map[string]string, len(header))
+			for i, v := range rec {
+				if i < len(header) {
+					m[header[i]] = v
+				}
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+	}
+}
+
+// DecodeCSV returns a fallible iterator that decodes the records read from r
+// into values of the struct type T.
+//
+// The first record is read as the header. Each column is stored in the
+// exported field of T whose `csv` struct tag, or failing that whose name,
+// equals the column header. Fields tagged `csv:"-"` are ignored, as are
+// columns with no matching field; fields with no matching column keep their
+// zero value.
+//
+// Supported field types are string, bool, the integer and floating-point
+// types, and types whose pointer implements encoding.TextUnmarshaler. An empty
+// cell leaves the field at its zero value. A cell that cannot be converted is
+// yielded as an error that names the line and column, and ends the iteration.
+//
+// Other errors are handled as in [CSVRows]. The sequence is single-pass.
+//
+// DecodeCSV panics if T is not a struct type or has a field of an
+// unsupported type that is not ignored.
+func DecodeCSV[T any](r *csv.Reader) iter.Seq2[T, error] {
+	fields := csvFields(reflect.TypeFor[T](), "DecodeCSV", canDecodeCSV)
+	return func(yield func(T, error) bool) {
+		var zero T
+		header, ok := readCSVHeader(r, func(err error) { yield(zero, err) })
+		if !ok {
+			return
+		}
+
+		byName := make(map[string]csvField, len(fields))
+		for _, f := range fields {
+			byName[f.name] = f
+		}
+		columns := make([]*csvField, len(header))
+		for i, name := range header {
+			if f, ok := byName[name]; ok {
+				columns[i] = &f
+			}
+		}
+
+		for rec, err := range CSVRows(r) {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			var v T
+			rv := reflect.ValueOf(&v).Elem()
+			for i, cell := range rec {
+				if i >= len(columns) || columns[i] == nil || cell == "" {
+					continue
+				}
+				if err := setCSVField(rv.Field(columns[i].index), cell); err != nil {
+					line, _ := r.FieldPos(i)
+					yield(zero, fmt.Errorf("itu: DecodeCSV: line %d, column %q: %w", line, columns[i].name, err))
+					return
+				}
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// EncodeCSV writes the values of seq to w as CSV records, preceded by a header
+// record, and flushes w.
+//
+// The columns are the exported fields of the struct type T in declaration
+// order, named and selected as described for [DecodeCSV]. Values of types
+// implementing encoding.TextMarshaler are written using MarshalText; other
+// supported types are formatted with the strconv package.
+//
+// EncodeCSV consumes seq eagerly and stops at the first write error, which it
+// returns.
+//
+// EncodeCSV panics if T is not a struct type or has a field of an
+// unsupported type that is not ignored. As with DecodeCSV, pointer and
+// interface fields are unsupported.
+func EncodeCSV[T any](w *csv.Writer, seq iter.Seq[T]) error {
+	fields := csvFields(reflect.TypeFor[T](), "EncodeCSV", canEncodeCSV)
+
+	rec := make([]string, len(fields))
+	for i, f := range fields {
+		rec[i] = f.name
+	}
+	if err := w.Write(rec); err != nil {
+		return err
+	}
+
+	for v := range seq {
+		rv := reflect.ValueOf(v)
+		for i, f := range fields {
+			s, err := formatCSVField(rv.Field(f.index))
+			if err != nil {
+				return fmt.Errorf("itu: EncodeCSV: column %q: %w", f.name, err)
+			}
+			rec[i] = s
+		}
+		if err := w.Write(rec); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// readCSVHeader reads the header record from r. It reports ok=false if the
+// input is empty or reading fails, passing any error other than io.EOF to fail.
+func readCSVHeader(r *csv.Reader, fail func(error)) (header []string, ok bool) {
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, false
+	}
+	if err != nil {
+		fail(err)
+		return nil, false
+	}
+	// The record may be reused by the next Read if r.ReuseRecord is set.
+	return append([]string(nil), header...), true
+}
+
+type csvField struct {
+	name  string
+	index int
+}
+
+var (
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// csvFields returns the columns of the struct type t. It panics if t is not a
+// struct, or if supported reports false for the type of one of its columns.
+func csvFields(t reflect.Type, fn string, supported func(reflect.Type) bool) []csvField {
+	if t.Kind() != reflect.Struct {
+		panic("itu: " + fn + ": T must be a struct type")
+	}
+	var fields []csvField
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		if !supported(sf.Type) {
+			panic(fmt.Sprintf("itu: %s: field %s has unsupported type %s", fn, sf.Name, sf.Type))
+		}
+		fields = append(fields, csvField{name: name, index: i})
+	}
+	return fields
+}
+
+func isBasicCSVKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// canDecodeCSV reports whether setCSVField supports fields of type t.
+func canDecodeCSV(t reflect.Type) bool {
+	return reflect.PointerTo(t).Implements(textUnmarshalerType) || isBasicCSVKind(t.Kind())
+}
+
+// canEncodeCSV reports whether formatCSVField supports fields of type t.
+// Pointer and interface fields are rejected, as they are by canDecodeCSV,
+// since a nil value has nothing to marshal.
+func canEncodeCSV(t reflect.Type) bool {
+	if k := t.Kind(); k == reflect.Pointer || k == reflect.Interface {
+		return false
+	}
+	return t.Implements(textMarshalerType) || isBasicCSVKind(t.Kind())
+}
+
+func setCSVField(v reflect.Value, s string) error {
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
+
+func formatCSVField(v reflect.Value) (string, error) {
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("unsupported field type %s", v.Type())
+	}
+}
@@ -0,0 +1,73 @@
+package itu_test
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+const exampleCSV = `city,country,population
+Tokyo,JP,37400000
+Delhi,IN,31000000
+Lyon,FR,516000
+`
+
+func ExampleCSVRows() {
+	r := csv.NewReader(strings.NewReader("a\tb\n1\t2\n"))
+	r.Comma = '\t' // read TSV
+	for rec, err := range itu.CSVRows(r) {
+		if err != nil {
+			fmt.Println("error:", err)
+			break
+		}
+		fmt.Println(rec)
+	}
+	// Output:
+	// [a b]
+	// [1 2]
+}
+
+func ExampleCSVMaps() {
+	r := csv.NewReader(strings.NewReader(exampleCSV))
+	for row, err := range itu.CSVMaps(r) {
+		if err != nil {
+			fmt.Println("error:", err)
+			break
+		}
+		fmt.Println(row["city"], row["country"])
+	}
+	// Output:
+	// Tokyo JP
+	// Delhi IN
+	// Lyon FR
+}
+
+type city struct {
+	Name       string `csv:"city"`
+	Population int    `csv:"population"`
+}
+
+func ExampleDecodeCSV() {
+	cities := itu.DecodeCSV[city](csv.NewReader(strings.NewReader(exampleCSV)))
+	big := itu.FilterErr(cities, func(c city) bool { return c.Population > 1_000_000 })
+	total, err := itu.FoldErr(big, 0, func(acc int, c city) (int, error) {
+		return acc + c.Population, nil
+	})
+	fmt.Println(total, err)
+	// Output:
+	// 68400000 <nil>
+}
+
+func ExampleEncodeCSV() {
+	cities := itu.Of(city{"Oslo", 709000}, city{"Bergen", 286000})
+	if err := itu.EncodeCSV(csv.NewWriter(os.Stdout), cities); err != nil {
+		fmt.Println("error:", err)
+	}
+	// Output:
+	// city,population
+	// Oslo,709000
+	// Bergen,286000
+}
@@ -0,0 +1,229 @@
+package itu
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type csvPerson struct {
+	Name    string  `csv:"name"`
+	Age     int     `csv:"age"`
+	Score   float64 `csv:"score"`
+	Active  bool
+	Secret  string `csv:"-"`
+	private string
+}
+
+func TestCSVRows(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("a,b\n1,\"x,y\"\n"))
+	got, err := CollectErr(CSVRows(r))
+	if err != nil {
+		t.Fatalf("CSVRows error = %v, want nil", err)
+	}
+	want := [][]string{{"a", "b"}, {"1", "x,y"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("CSVRows = %q, want %q", got, want)
+	}
+}
+
+func TestCSVRows_TSV(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("a\tb c\n1\t2\n"))
+	r.Comma = '\t'
+	got, err := CollectErr(CSVRows(r))
+	want := [][]string{{"a", "b c"}, {"1", "2"}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("CSVRows(TSV) = (%q, %v), want (%q, nil)", got, err, want)
+	}
+}
+
+func TestCSVRows_ParseErrorEndsIteration(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("a,b\n1,2,3\n4,5\n"))
+	got, err := CollectErr(CSVRows(r))
+	var perr *csv.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("CSVRows error = %v, want *csv.ParseError", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("CSVRows before error = %q, want 1 record", got)
+	}
+}
+
+func TestCSVMaps(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("id,name\n1,ann\n2,bob\n"))
+	got, err := CollectErr(CSVMaps(r))
+	want := []map[string]string{{"id": "1", "name": "ann"}, {"id": "2", "name": "bob"}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("CSVMaps = (%v, %v), want (%v, nil)", got, err, want)
+	}
+}
+
+func TestCSVMaps_EmptyInput(t *testing.T) {
+	got, err := CollectErr(CSVMaps(csv.NewReader(strings.NewReader(""))))
+	if err != nil || len(got) != 0 {
+		t.Fatalf("CSVMaps(empty) = (%v, %v), want (empty, nil)", got, err)
+	}
+}
+
+func TestCSVMaps_ReuseRecordKeepsHeader(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("k,v\na,1\nb,2\n"))
+	r.ReuseRecord = true
+	got, _ := CollectErr(CSVMaps(r))
+	want := []map[string]string{{"k": "a", "v": "1"}, {"k": "b", "v": "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("CSVMaps(ReuseRecord) = %v, want %v", got, want)
+	}
+}
+
+func TestDecodeCSV_MapsColumnsToFields(t *testing.T) {
+	input := "score,name,extra,Active,age,Secret\n" +
+		"9.5,ann,x,true,31,s1\n" +
+		",bob,,,,\n"
+	got, err := CollectErr(DecodeCSV[csvPerson](csv.NewReader(strings.NewReader(input))))
+	if err != nil {
+		t.Fatalf("DecodeCSV error = %v, want nil", err)
+	}
+	want := []csvPerson{
+		{Name: "ann", Age: 31, Score: 9.5, Active: true},
+		{Name: "bob"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("DecodeCSV = %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeCSV_TextUnmarshaler(t *testing.T) {
+	type event struct {
+		At time.Time `csv:"at"`
+	}
+	input := "at\n2024-05-01T10:00:00Z\n"
+	got, err := CollectErr(DecodeCSV[event](csv.NewReader(strings.NewReader(input))))
+	want := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	if err != nil || len(got) != 1 || !got[0].At.Equal(want) {
+		t.Fatalf("DecodeCSV(time) = (%v, %v), want [%v]", got, err, want)
+	}
+}
+
+func TestDecodeCSV_ConversionError(t *testing.T) {
+	input := "name,age\nann,31\nbob,old\ncid,40\n"
+	got, err := CollectErr(DecodeCSV[csvPerson](csv.NewReader(strings.NewReader(input))))
+	if err == nil || !strings.Contains(err.Error(), `line 3, column "age"`) {
+		t.Fatalf("DecodeCSV error = %v, want error mentioning line 3 and column age", err)
+	}
+	if len(got) != 1 || got[0].Name != "ann" {
+		t.Fatalf("DecodeCSV before error = %+v, want [ann]", got)
+	}
+}
+
+func TestDecodeCSV_PanicsOnNonStruct(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("DecodeCSV[int] did not panic, want panic")
+		}
+	}()
+	_ = DecodeCSV[int](csv.NewReader(strings.NewReader("")))
+}
+
+type csvUnsupported struct {
+	Name    string
+	Tags    []string
+	Ignored map[string]int `csv:"-"`
+}
+
+func TestDecodeCSV_PanicsOnUnsupportedField(t *testing.T) {
+	defer func() {
+		r := recover()
+		if msg, _ := r.(string); !strings.Contains(msg, "field Tags") {
+			t.Fatalf("DecodeCSV[csvUnsupported] panic = %v, want unsupported field Tags", r)
+		}
+	}()
+	// The panic happens up front, even though no cell would reach Tags.
+	_ = DecodeCSV[csvUnsupported](csv.NewReader(strings.NewReader("Name\nann\n")))
+}
+
+func TestEncodeCSV_PanicsOnUnsupportedField(t *testing.T) {
+	defer func() {
+		r := recover()
+		if msg, _ := r.(string); !strings.Contains(msg, "field Tags") {
+			t.Fatalf("EncodeCSV[csvUnsupported] panic = %v, want unsupported field Tags", r)
+		}
+	}()
+	_ = EncodeCSV(csv.NewWriter(io.Discard), Empty[csvUnsupported]())
+}
+
+func TestEncodeCSV_PanicsOnPointerField(t *testing.T) {
+	type row struct {
+		At *time.Time
+	}
+	defer func() {
+		r := recover()
+		if msg, _ := r.(string); !strings.Contains(msg, "field At") {
+			t.Fatalf("EncodeCSV(*time.Time field) panic = %v, want unsupported field At", r)
+		}
+	}()
+	// Rejected up front, rather than panicking in MarshalText on a nil value.
+	_ = EncodeCSV(csv.NewWriter(io.Discard), Of(row{At: nil}))
+}
+
+func TestCSV_TextFieldsSupported(t *testing.T) {
+	type row struct {
+		At time.Time `csv:"at"`
+	}
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var buf bytes.Buffer
+	if err := EncodeCSV(csv.NewWriter(&buf), Of(row{at})); err != nil {
+		t.Fatalf("EncodeCSV(time.Time) error = %v", err)
+	}
+	got, err := CollectErr(DecodeCSV[row](csv.NewReader(&buf)))
+	if err != nil || len(got) != 1 || !got[0].At.Equal(at) {
+		t.Fatalf("DecodeCSV(time.Time) = (%v, %v), want [%v]", got, err, at)
+	}
+}
+
+func TestEncodeCSV_RoundTrip(t *testing.T) {
+	people := []csvPerson{
+		{Name: "ann", Age: 31, Score: 9.5, Active: true, Secret: "hidden"},
+		{Name: "b,ob", Age: -1, Score: 0.1},
+	}
+	var buf bytes.Buffer
+	if err := EncodeCSV(csv.NewWriter(&buf), slices.Values(people)); err != nil {
+		t.Fatalf("EncodeCSV error = %v, want nil", err)
+	}
+	wantText := "name,age,score,Active\nann,31,9.5,true\n\"b,ob\",-1,0.1,false\n"
+	if buf.String() != wantText {
+		t.Fatalf("EncodeCSV wrote %q, want %q", buf.String(), wantText)
+	}
+
+	got, err := CollectErr(DecodeCSV[csvPerson](csv.NewReader(&buf)))
+	people[0].Secret = ""
+	if err != nil || !reflect.DeepEqual(got, people) {
+		t.Fatalf("DecodeCSV(EncodeCSV) = (%+v, %v), want %+v", got, err, people)
+	}
+}
+
+func TestEncodeCSV_EmptySeqWritesHeader(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeCSV(csv.NewWriter(&buf), Empty[csvPerson]()); err != nil {
+		t.Fatalf("EncodeCSV error = %v, want nil", err)
+	}
+	if buf.String() != "name,age,score,Active\n" {
+		t.Fatalf("EncodeCSV(empty) wrote %q", buf.String())
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errBoom }
+
+func TestEncodeCSV_ReturnsWriteError(t *testing.T) {
+	err := EncodeCSV(csv.NewWriter(failingWriter{}), Of(csvPerson{Name: "x"}))
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("EncodeCSV error = %v, want %v", err, errBoom)
+	}
+}
@@ -0,0 +1,130 @@
+package itu
+
+import (
+	"iter"
+	"slices"
+)
+
+// Cursor is a pull-based reader over a sequence with arbitrary lookahead and
+// push-back, for hand-written parsers and tokenizers.
+//
+// A Cursor is created with [NewCursor] and must be released with
+// [Cursor.Close] once it is no longer needed, unless the underlying sequence
+// has been read to the end. A Cursor is not safe for concurrent use.
+type Cursor[T any] struct {
+	next func() (T, bool)
+	stop func()
+	// buf holds values that were peeked or unread, in the order they will be
+	// returned by Next.
+	buf    []T
+	closed bool
+}
+
+// NewCursor returns a Cursor reading from seq. No values are pulled from seq
+// until the cursor is first read from.
+func NewCursor[T any](seq iter.Seq[T]) *Cursor[T] {
+	next, stop := iter.Pull(seq)
+	return &Cursor[T]{next: next, stop: stop}
+}
+
+// Next returns the next value and advances the cursor. If there are no more
+// values, it returns (zero, false).
+func (c *Cursor[T]) Next() (T, bool) {
+	if len(c.buf) > 0 {
+		v := c.buf[0]
+		var zero T
+		c.buf[0] = zero
+		c.buf = c.buf[1:]
+		return v, true
+	}
+	if c.closed {
+		var zero T
+		return zero, false
+	}
+	return c.next()
+}
+
+// Peek returns the next value without advancing the cursor. If there are no
+// more values, it returns (zero, false).
+func (c *Cursor[T]) Peek() (T, bool) {
+	if !c.fill(1) {
+		var zero T
+		return zero, false
+	}
+	return c.buf[0], true
+}
+
+// PeekN returns up to n upcoming values without advancing the cursor. The
+// result is shorter than n only if the sequence ends first. The returned slice
+// is a copy and may be modified freely.
+//
+// PeekN panics if n is negative.
+func (c *Cursor[T]) PeekN(n int) []T {
+	if n < 0 {
+		panic("itu: Cursor.PeekN: n is negative")
+	}
+	c.fill(n)
+	return slices.Clone(c.buf[:min(n, len(c.buf))])
+}
+
+// Unread pushes v back onto the cursor, so that it is returned by the next
+// call to Next or Peek. Values may be unread repeatedly; they come back in
+// reverse order of unreading. v need not be a value previously read.
+func (c *Cursor[T]) Unread(v T) {
+	c.buf = slices.Insert(c.buf, 0, v)
+}
+
+// NextIf returns the next value and advances the cursor if pred reports true
+// for it. Otherwise the cursor is left unchanged and NextIf returns
+// (zero, false).
+func (c *Cursor[T]) NextIf(pred func(T) bool) (T, bool) {
+	if pred == nil {
+		panic("itu: Cursor.NextIf: pred is nil")
+	}
+	if v, ok := c.Peek(); ok && pred(v) {
+		return c.Next()
+	}
+	var zero T
+	return zero, false
+}
+
+// NextWhile reads values while pred reports true for them and returns them.
+// The first value for which pred reports false is left in the cursor.
+func (c *Cursor[T]) NextWhile(pred func(T) bool) []T {
+	if pred == nil {
+		panic("itu: Cursor.NextWhile: pred is nil")
+	}
+	var out []T
+	for {
+		v, ok := c.NextIf(pred)
+		if !ok {
+			return out
+		}
+		out = append(out, v)
+	}
+}
+
+// Close stops the underlying sequence and discards any values that were
+// peeked but not yet read. Values unread after Close are still returned by
+// Next. Close may be called more than once.
+func (c *Cursor[T]) Close() {
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.buf = nil
+	c.stop()
+}
+
+// fill pulls values into buf until it holds at least n of them, and reports
+// whether it does.
+func (c *Cursor[T]) fill(n int) bool {
+	for len(c.buf) < n && !c.closed {
+		v, ok := c.next()
+		if !ok {
+			break
+		}
+		c.buf = append(c.buf, v)
+	}
+	return len(c.buf) >= n
+}
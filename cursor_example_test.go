@@ -0,0 +1,65 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"unicode"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleCursor() {
+	// A tiny tokenizer splitting input into numbers, words and symbols.
+	c := itu.NewCursor(slices.Values([]rune("x1 = 42+y")))
+	defer c.Close()
+
+	for {
+		r, ok := c.Peek()
+		if !ok {
+			break
+		}
+		switch {
+		case unicode.IsSpace(r):
+			c.Next()
+		case unicode.IsDigit(r):
+			fmt.Printf("num %s\n", string(c.NextWhile(unicode.IsDigit)))
+		case unicode.IsLetter(r):
+			word := c.NextWhile(func(r rune) bool {
+				return unicode.IsLetter(r) || unicode.IsDigit(r)
+			})
+			fmt.Printf("word %s\n", string(word))
+		default:
+			c.Next()
+			fmt.Printf("sym %c\n", r)
+		}
+	}
+	// Output:
+	// word x1
+	// sym =
+	// num 42
+	// sym +
+	// word y
+}
+
+func ExampleCursor_PeekN() {
+	c := itu.NewCursor(slices.Values([]string{"-", "-", "x"}))
+	defer c.Close()
+	if slices.Equal(c.PeekN(2), []string{"-", "-"}) {
+		c.Next()
+		c.Next()
+		fmt.Println("long option")
+	}
+	fmt.Println(c.Next())
+	// Output:
+	// long option
+	// x true
+}
+
+func ExampleCursor_Unread() {
+	c := itu.NewCursor(itu.Of(2, 3))
+	defer c.Close()
+	c.Unread(1)
+	fmt.Println(c.PeekN(5))
+	// Output:
+	// [1 2 3]
+}
@@ -0,0 +1,155 @@
+package itu
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCursor_NextAndPeek(t *testing.T) {
+	c := NewCursor(slices.Values([]int{1, 2, 3}))
+	defer c.Close()
+
+	if v, ok := c.Peek(); !ok || v != 1 {
+		t.Fatalf("Peek = (%d, %v), want (1, true)", v, ok)
+	}
+	var got []int
+	for {
+		v, ok := c.Next()
+		if !ok {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("Next values = %v, want [1 2 3]", got)
+	}
+	if v, ok := c.Peek(); ok {
+		t.Fatalf("Peek at end = (%d, true), want (0, false)", v)
+	}
+}
+
+func TestCursor_Lazy(t *testing.T) {
+	produced := 0
+	c := NewCursor(func(yield func(int) bool) {
+		for i := range 4 {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+	defer c.Close()
+	if produced != 0 {
+		t.Fatalf("NewCursor pulled %d values, want 0", produced)
+	}
+	c.PeekN(2)
+	if produced != 2 {
+		t.Fatalf("PeekN(2) pulled %d values, want 2", produced)
+	}
+	c.Next()
+	c.Next()
+	if produced != 2 {
+		t.Fatalf("Next after PeekN pulled %d values, want 2", produced)
+	}
+}
+
+func TestCursor_PeekN(t *testing.T) {
+	c := NewCursor(slices.Values([]int{1, 2, 3}))
+	defer c.Close()
+
+	if got := c.PeekN(2); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("PeekN(2) = %v, want [1 2]", got)
+	}
+	got := c.PeekN(5)
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("PeekN(5) = %v, want [1 2 3]", got)
+	}
+	got[0] = 100
+	if v, _ := c.Next(); v != 1 {
+		t.Fatalf("Next after modifying PeekN result = %d, want 1", v)
+	}
+	if got := c.PeekN(0); len(got) != 0 {
+		t.Fatalf("PeekN(0) = %v, want empty", got)
+	}
+}
+
+func TestCursor_PeekNNegativePanics(t *testing.T) {
+	c := NewCursor(slices.Values([]int{1}))
+	defer c.Close()
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("PeekN(-1) did not panic")
+		}
+	}()
+	c.PeekN(-1)
+}
+
+func TestCursor_Unread(t *testing.T) {
+	c := NewCursor(slices.Values([]int{3, 4}))
+	defer c.Close()
+
+	c.Peek()
+	c.Unread(2)
+	c.Unread(1)
+	var got []int
+	for v, ok := c.Next(); ok; v, ok = c.Next() {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("values after Unread = %v, want [1 2 3 4]", got)
+	}
+	c.Unread(5)
+	if v, ok := c.Next(); !ok || v != 5 {
+		t.Fatalf("Next after Unread at end = (%d, %v), want (5, true)", v, ok)
+	}
+}
+
+func TestCursor_NextIfAndNextWhile(t *testing.T) {
+	c := NewCursor(slices.Values([]rune("123+45")))
+	defer c.Close()
+	isDigit := func(r rune) bool { return r >= '0' && r <= '9' }
+
+	if got := string(c.NextWhile(isDigit)); got != "123" {
+		t.Fatalf("NextWhile(digit) = %q, want \"123\"", got)
+	}
+	if v, ok := c.NextIf(isDigit); ok {
+		t.Fatalf("NextIf(digit) on '+' = (%q, true), want (0, false)", v)
+	}
+	if v, ok := c.NextIf(func(r rune) bool { return r == '+' }); !ok || v != '+' {
+		t.Fatalf("NextIf('+') = (%q, %v), want ('+', true)", v, ok)
+	}
+	if got := string(c.NextWhile(isDigit)); got != "45" {
+		t.Fatalf("NextWhile(digit) = %q, want \"45\"", got)
+	}
+	if got := c.NextWhile(isDigit); got != nil {
+		t.Fatalf("NextWhile at end = %v, want nil", got)
+	}
+}
+
+func TestCursor_CloseStopsSource(t *testing.T) {
+	produced := 0
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := range 10 {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	c := NewCursor(seq)
+	c.PeekN(3)
+	c.Close()
+	c.Close()
+	if !stopped || produced != 3 {
+		t.Fatalf("Close: stopped = %v, produced = %d, want true, 3", stopped, produced)
+	}
+	if v, ok := c.Next(); ok {
+		t.Fatalf("Next after Close = (%d, true), want (0, false)", v)
+	}
+	c.Unread(7)
+	if v, ok := c.Peek(); !ok || v != 7 {
+		t.Fatalf("Peek after Close and Unread = (%d, %v), want (7, true)", v, ok)
+	}
+}
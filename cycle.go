@@ -1,24 +1,90 @@
 package itu
 
-import (
-	"iter"
-	"slices"
-)
+import "iter"
 
 // Cycle returns a lazy iterator that repeats seq in a cycle.
 //
-// Cycle consumes seq eagerly to build an internal slice copy of all values,
-// then returns an iterator that yields those values repeatedly until the
-// consumer stops.
+// Cycle yields the values of seq as they are produced while copying them into
+// an internal buffer, then replays the buffer repeatedly until the consumer
+// stops. seq is read only as far as the consumer reads, so cycling a very large
+// or infinite sequence is fine as long as the consumer stops early; an
+// infinite seq is never repeated.
 //
-// If seq yields no values, Cycle returns an empty iterator.
+// Once seq has been read to its end, the buffer is kept and later iterations
+// of the result replay it without running seq again, so Cycle works with
+// single-pass sources. An iteration that stops before seq ends keeps nothing,
+// and the next iteration reads seq from the start again. The result is not
+// safe for concurrent use from several goroutines.
+//
+// If seq yields no values, the result yields no values either.
 func Cycle[T any](seq iter.Seq[T]) iter.Seq[T] {
-	items := slices.Collect(seq)
-	if len(items) == 0 {
+	return cycle(seq, -1)
+}
+
+// Cycle2 returns a lazy iterator that repeats pairs (a, b) from seq in a cycle.
+//
+// Cycle2 yields the pairs of seq as they are produced while copying them into
+// an internal buffer, then replays the buffer repeatedly until the consumer
+// stops. Like [Cycle], it reads seq only as far as the consumer does, and
+// replays the buffer on later iterations once seq has been read to its end.
+//
+// If seq yields no pairs, the result yields no pairs either.
+func Cycle2[A, B any](seq iter.Seq2[A, B]) iter.Seq2[A, B] {
+	return cycle2(seq, -1)
+}
+
+// CycleN returns a lazy iterator that yields the values of seq times times in
+// a row. Like [Cycle], it buffers the values of seq during the first round and
+// replays them afterwards, and keeps the buffer for later iterations of the
+// result once seq has been read to its end.
+//
+// If times is zero or seq yields no values, the result yields no values.
+//
+// CycleN panics if times is negative.
+func CycleN[T any](seq iter.Seq[T], times int) iter.Seq[T] {
+	if times < 0 {
+		panic("itu: CycleN: times must be non-negative")
+	}
+	return cycle(seq, times)
+}
+
+// CycleN2 returns a lazy iterator that yields the pairs of seq times times in
+// a row, buffering them like [CycleN].
+//
+// If times is zero or seq yields no pairs, the result yields no pairs.
+//
+// CycleN2 panics if times is negative.
+func CycleN2[A, B any](seq iter.Seq2[A, B], times int) iter.Seq2[A, B] {
+	if times < 0 {
+		panic("itu: CycleN2: times must be non-negative")
+	}
+	return cycle2(seq, times)
+}
+
+// cycle repeats seq times times, or forever if times is negative.
+func cycle[T any](seq iter.Seq[T], times int) iter.Seq[T] {
+	if times == 0 {
 		return Empty[T]()
 	}
+	var items []T
+	complete := false
 	return func(yield func(T) bool) {
-		for {
+		round := 0
+		if !complete {
+			var buf []T
+			for v := range seq {
+				buf = append(buf, v)
+				if !yield(v) {
+					return
+				}
+			}
+			items, complete = buf, true
+			round = 1
+		}
+		if len(items) == 0 {
+			return
+		}
+		for ; times < 0 || round < times; round++ {
 			for _, v := range items {
 				if !yield(v) {
 					return
@@ -28,24 +94,30 @@ func Cycle[T any](seq iter.Seq[T]) iter.Seq[T] {
 	}
 }
 
-// Cycle2 returns a lazy iterator that repeats pairs (a, b) from seq in a cycle.
-//
-// Cycle2 consumes seq eagerly to build an internal slice copy of all pairs,
-// then returns an iterator that yields those pairs repeatedly until the
-// consumer stops.
-//
-// If seq yields no pairs, Cycle2 returns an empty iterator.
-func Cycle2[A, B any](seq iter.Seq2[A, B]) iter.Seq2[A, B] {
-	var items []pair[A, B]
-	for a, b := range seq {
-		items = append(items, pair[A, B]{First: a, Second: b})
-	}
-	if len(items) == 0 {
+// cycle2 repeats seq times times, or forever if times is negative.
+func cycle2[A, B any](seq iter.Seq2[A, B], times int) iter.Seq2[A, B] {
+	if times == 0 {
 		return Empty2[A, B]()
 	}
-
+	var items []pair[A, B]
+	complete := false
 	return func(yield func(A, B) bool) {
-		for {
+		round := 0
+		if !complete {
+			var buf []pair[A, B]
+			for a, b := range seq {
+				buf = append(buf, pair[A, B]{First: a, Second: b})
+				if !yield(a, b) {
+					return
+				}
+			}
+			items, complete = buf, true
+			round = 1
+		}
+		if len(items) == 0 {
+			return
+		}
+		for ; times < 0 || round < times; round++ {
 			for _, p := range items {
 				if !yield(p.First, p.Second) {
 					return
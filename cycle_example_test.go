@@ -46,3 +46,20 @@ func ExampleCycle2() {
 	// 1 b
 	// 0 a
 }
+
+func ExampleCycleN() {
+	fmt.Println(slices.Collect(itu.CycleN(itu.Of("tick", "tock"), 2)))
+	// Output:
+	// [tick tock tick tock]
+}
+
+func ExampleCycleN2() {
+	for i, v := range itu.CycleN2(slices.All([]string{"a", "b"}), 2) {
+		fmt.Printf("%d %s\n", i, v)
+	}
+	// Output:
+	// 0 a
+	// 1 b
+	// 0 a
+	// 1 b
+}
@@ -35,3 +35,159 @@ func TestCycle2_RepeatsPairs(t *testing.T) {
 		t.Fatalf("Cycle2([1a 2b 3c]) first 8 = %v, want %v", got, want)
 	}
 }
+
+func TestCycle_Lazy(t *testing.T) {
+	produced := 0
+	infinite := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	c := Cycle(infinite)
+	if produced != 0 {
+		t.Fatalf("Cycle pulled %d values before iteration, want 0", produced)
+	}
+	if got := slices.Collect(Take(c, 3)); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Take(Cycle(infinite), 3) = %v, want [0 1 2]", got)
+	}
+	if produced != 3 {
+		t.Fatalf("Take(Cycle(infinite), 3) pulled %d values, want 3", produced)
+	}
+}
+
+func TestCycle_ReplaysBufferOnLaterIterations(t *testing.T) {
+	runs := 0
+	seq := func(yield func(int) bool) {
+		runs++
+		for i := range 2 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	c := Cycle(seq)
+	for range 2 {
+		if got := slices.Collect(Take(c, 5)); !slices.Equal(got, []int{0, 1, 0, 1, 0}) {
+			t.Fatalf("Take(Cycle, 5) = %v, want [0 1 0 1 0]", got)
+		}
+	}
+	if runs != 1 {
+		t.Fatalf("Cycle ran the source %d times in 2 iterations, want 1", runs)
+	}
+}
+
+func TestCycle_SinglePassSource(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	c := Cycle(FromChan(ch))
+	for range 2 {
+		if got := slices.Collect(Take(c, 3)); !slices.Equal(got, []int{1, 2, 1}) {
+			t.Fatalf("Take(Cycle(FromChan), 3) = %v, want [1 2 1]", got)
+		}
+	}
+}
+
+func TestCycle_RereadsSourceAfterPartialPass(t *testing.T) {
+	runs := 0
+	seq := func(yield func(int) bool) {
+		runs++
+		for i := range 3 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	c := Cycle(seq)
+	if got := slices.Collect(Take(c, 2)); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("Take(Cycle, 2) = %v, want [0 1]", got)
+	}
+	if got := slices.Collect(Take(c, 4)); !slices.Equal(got, []int{0, 1, 2, 0}) {
+		t.Fatalf("Take(Cycle, 4) = %v, want [0 1 2 0]", got)
+	}
+	if got := slices.Collect(Take(c, 4)); !slices.Equal(got, []int{0, 1, 2, 0}) {
+		t.Fatalf("third Take(Cycle, 4) = %v, want [0 1 2 0]", got)
+	}
+	if runs != 2 {
+		t.Fatalf("Cycle ran the source %d times, want 2", runs)
+	}
+}
+
+func TestCycle2_Lazy(t *testing.T) {
+	infinite := func(yield func(int, int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i, -i) {
+				return
+			}
+		}
+	}
+	got := collect2(Take2(Cycle2(infinite), 2))
+	want := []pair[int, int]{{0, 0}, {1, -1}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Take2(Cycle2(infinite), 2) = %v, want %v", got, want)
+	}
+}
+
+func TestCycleN(t *testing.T) {
+	got := slices.Collect(CycleN(Of(1, 2), 3))
+	if want := []int{1, 2, 1, 2, 1, 2}; !slices.Equal(got, want) {
+		t.Fatalf("CycleN([1 2], 3) = %v, want %v", got, want)
+	}
+	if got := slices.Collect(CycleN(Of(1, 2), 1)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("CycleN([1 2], 1) = %v, want [1 2]", got)
+	}
+	if got := slices.Collect(CycleN(Of(1, 2), 0)); len(got) != 0 {
+		t.Fatalf("CycleN([1 2], 0) = %v, want empty", got)
+	}
+	if got := slices.Collect(CycleN(Empty[int](), 5)); len(got) != 0 {
+		t.Fatalf("CycleN(empty, 5) = %v, want empty", got)
+	}
+}
+
+func TestCycleN_SourceRunsOnce(t *testing.T) {
+	runs := 0
+	seq := func(yield func(int) bool) {
+		runs++
+		yield(7)
+	}
+	if got := slices.Collect(CycleN(seq, 4)); !slices.Equal(got, []int{7, 7, 7, 7}) || runs != 1 {
+		t.Fatalf("CycleN(seq, 4) = %v in %d runs, want [7 7 7 7] in 1", got, runs)
+	}
+}
+
+func TestCycleN_NegativePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("CycleN(-1) did not panic")
+		}
+	}()
+	CycleN(Of(1), -1)
+}
+
+func TestCycleN2(t *testing.T) {
+	got := collect2(CycleN2(slices.All([]string{"a", "b"}), 2))
+	want := []pair[int, string]{{0, "a"}, {1, "b"}, {0, "a"}, {1, "b"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("CycleN2 = %v, want %v", got, want)
+	}
+	if got := collect2(CycleN2(Empty2[int, int](), 3)); len(got) != 0 {
+		t.Fatalf("CycleN2(empty) = %v, want empty", got)
+	}
+}
+
+func TestCycleN_SinglePassSourceTwice(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	c := CycleN(FromChan(ch), 2)
+	for range 2 {
+		if got := slices.Collect(c); !slices.Equal(got, []int{1, 2, 1, 2}) {
+			t.Fatalf("CycleN(FromChan, 2) = %v, want [1 2 1 2]", got)
+		}
+	}
+}
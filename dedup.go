@@ -0,0 +1,151 @@
+package itu
+
+import (
+	"container/list"
+	"iter"
+)
+
+// Dedup returns a lazy iterator that yields the elements of seq, dropping each
+// element that is equal to the one right before it.
+//
+// For example, for seq producing [1 1 2 2 2 1 3], Dedup yields [1 2 1 3]. Only
+// consecutive duplicates are removed; see [Unique] to drop all repeats.
+//
+// Values are produced only as the returned iterator is consumed.
+func Dedup[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return DedupBy(seq, func(v T) T { return v })
+}
+
+// DedupBy returns a lazy iterator that yields the elements of seq, dropping
+// each element whose key, as computed by keyFn, is equal to the key of the
+// element right before it.
+//
+// The first element of each run of equal keys is yielded.
+//
+// Values are produced only as the returned iterator is consumed.
+func DedupBy[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var prev K
+		first := true
+		for v := range seq {
+			k := keyFn(v)
+			if !first && k == prev {
+				continue
+			}
+			first = false
+			prev = k
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Unique returns a lazy iterator that yields the elements of seq that have not
+// been yielded before.
+//
+// Unique remembers every distinct element it has seen, so its memory grows
+// with the number of distinct elements; see [UniqueLRU] for a bounded variant.
+// Each iteration of the returned iterator starts with an empty memory.
+//
+// Values are produced only as the returned iterator is consumed.
+func Unique[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return UniqueBy(seq, func(v T) T { return v })
+}
+
+// UniqueBy returns a lazy iterator that yields the elements of seq whose key,
+// as computed by keyFn, has not been seen before.
+//
+// The first element with each key is yielded. UniqueBy remembers every
+// distinct key it has seen; see [UniqueByLRU] for a bounded variant.
+//
+// Values are produced only as the returned iterator is consumed.
+func UniqueBy[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for v := range seq {
+			k := keyFn(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// UniqueLRU is like [Unique] but remembers at most size distinct elements.
+//
+// When the memory is full, the least recently seen element is forgotten; an
+// element counts as seen both when it is yielded and when it is dropped as a
+// duplicate. A forgotten element is yielded again if it reappears. This makes
+// UniqueLRU suitable for unbounded streams in which duplicates appear close to
+// each other, such as retried events.
+//
+// Values are produced only as the returned iterator is consumed.
+//
+// UniqueLRU panics if size is not positive.
+func UniqueLRU[T comparable](seq iter.Seq[T], size int) iter.Seq[T] {
+	if size <= 0 {
+		panic("itu: UniqueLRU: size must be positive")
+	}
+	return uniqueByLRU(seq, func(v T) T { return v }, size)
+}
+
+// UniqueByLRU is like [UniqueBy] but remembers at most size distinct keys,
+// forgetting the least recently seen key when the memory is full, as described
+// for [UniqueLRU].
+//
+// Values are produced only as the returned iterator is consumed.
+//
+// UniqueByLRU panics if size is not positive.
+func UniqueByLRU[T any, K comparable](seq iter.Seq[T], keyFn func(T) K, size int) iter.Seq[T] {
+	if size <= 0 {
+		panic("itu: UniqueByLRU: size must be positive")
+	}
+	return uniqueByLRU(seq, keyFn, size)
+}
+
+func uniqueByLRU[T any, K comparable](seq iter.Seq[T], keyFn func(T) K, size int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := newLRUSet[K](size)
+		for v := range seq {
+			if seen.touch(keyFn(v)) {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// lruSet is a set of at most size keys that evicts the least recently touched
+// key when a new key is added to a full set.
+type lruSet[K comparable] struct {
+	size  int
+	order *list.List // front is the most recently touched key
+	index map[K]*list.Element
+}
+
+func newLRUSet[K comparable](size int) *lruSet[K] {
+	return &lruSet[K]{size: size, order: list.New(), index: make(map[K]*list.Element)}
+}
+
+// touch marks k as the most recently used key. It reports whether k was
+// already in the set.
+func (s *lruSet[K]) touch(k K) bool {
+	if e, ok := s.index[k]; ok {
+		s.order.MoveToFront(e)
+		return true
+	}
+	if s.order.Len() >= s.size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.index, oldest.Value.(K))
+	}
+	s.index[k] = s.order.PushFront(k)
+	return false
+}
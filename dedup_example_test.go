@@ -0,0 +1,59 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleDedup() {
+	fmt.Println(slices.Collect(itu.Dedup(itu.Of(1, 1, 2, 2, 2, 1, 3))))
+	// Output:
+	// [1 2 1 3]
+}
+
+func ExampleDedupBy() {
+	words := itu.Of("Go", "go", "GO", "Rust", "go")
+	fmt.Println(slices.Collect(itu.DedupBy(words, strings.ToLower)))
+	// Output:
+	// [Go Rust go]
+}
+
+func ExampleUnique() {
+	fmt.Println(slices.Collect(itu.Unique(itu.Of("b", "a", "b", "c", "a"))))
+	// Output:
+	// [b a c]
+}
+
+func ExampleUniqueBy() {
+	type event struct {
+		ID      int
+		Attempt int
+	}
+	events := itu.Of(event{1, 1}, event{2, 1}, event{1, 2}, event{3, 1}, event{2, 2})
+	for e := range itu.UniqueBy(events, func(e event) int { return e.ID }) {
+		fmt.Println(e.ID, e.Attempt)
+	}
+	// Output:
+	// 1 1
+	// 2 1
+	// 3 1
+}
+
+func ExampleUniqueLRU() {
+	// Only the 2 most recently seen values are remembered, so 1 is yielded
+	// again after 2 and 3 have pushed it out.
+	fmt.Println(slices.Collect(itu.UniqueLRU(itu.Of(1, 1, 2, 3, 1), 2)))
+	// Output:
+	// [1 2 3 1]
+}
+
+func ExampleUniqueByLRU() {
+	ids := itu.Of("a-1", "a-2", "b-1", "c-1", "a-3")
+	prefix := func(s string) string { return s[:1] }
+	fmt.Println(slices.Collect(itu.UniqueByLRU(ids, prefix, 2)))
+	// Output:
+	// [a-1 b-1 c-1 a-3]
+}
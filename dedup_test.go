@@ -0,0 +1,126 @@
+package itu
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDedup_DropsConsecutiveDuplicates(t *testing.T) {
+	got := slices.Collect(Dedup(Of(1, 1, 2, 2, 2, 1, 3, 3)))
+	want := []int{1, 2, 1, 3}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Dedup = %v, want %v", got, want)
+	}
+}
+
+func TestDedup_ZeroValueFirst(t *testing.T) {
+	got := slices.Collect(Dedup(Of(0, 0, 1)))
+	want := []int{0, 1}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Dedup([0 0 1]) = %v, want %v", got, want)
+	}
+}
+
+func TestDedup_Empty(t *testing.T) {
+	if got := slices.Collect(Dedup(Empty[int]())); len(got) != 0 {
+		t.Fatalf("Dedup(empty) = %v, want empty", got)
+	}
+}
+
+func TestDedupBy_KeepsFirstOfRun(t *testing.T) {
+	got := slices.Collect(DedupBy(Of("a", "A", "b", "B", "a"), strings.ToLower))
+	want := []string{"a", "b", "a"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("DedupBy(lower) = %v, want %v", got, want)
+	}
+}
+
+func TestDedup_StopsWhenConsumerStops(t *testing.T) {
+	produced := 0
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{1, 1, 2, 3} {
+			produced++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	got := slices.Collect(Take(Dedup(seq), 2))
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Dedup first 2 = %v, want [1 2]", got)
+	}
+	if produced != 3 {
+		t.Fatalf("Dedup consumed %d values, want 3", produced)
+	}
+}
+
+func TestUnique_DropsAllRepeats(t *testing.T) {
+	got := slices.Collect(Unique(Of(3, 1, 3, 2, 1, 4)))
+	want := []int{3, 1, 2, 4}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Unique = %v, want %v", got, want)
+	}
+}
+
+func TestUnique_FreshMemoryPerIteration(t *testing.T) {
+	seq := Unique(Of(1, 2, 1))
+	first := slices.Collect(seq)
+	second := slices.Collect(seq)
+	if !slices.Equal(first, second) || !slices.Equal(first, []int{1, 2}) {
+		t.Fatalf("Unique iterations = %v and %v, want [1 2] twice", first, second)
+	}
+}
+
+func TestUniqueBy_KeepsFirstWithKey(t *testing.T) {
+	got := slices.Collect(UniqueBy(Of("apple", "avocado", "banana", "blueberry", "cherry"), func(s string) byte { return s[0] }))
+	want := []string{"apple", "banana", "cherry"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("UniqueBy(first letter) = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueLRU_ForgetsLeastRecentlySeen(t *testing.T) {
+	// With room for 2 keys, the repeated 1 refreshes it, so 3 evicts 2 rather
+	// than 1, and the final 2 is yielded again.
+	got := slices.Collect(UniqueLRU(Of(1, 2, 1, 3, 1, 2), 2))
+	want := []int{1, 2, 3, 2}
+	if !slices.Equal(got, want) {
+		t.Fatalf("UniqueLRU(_, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueLRU_LargeWindowMatchesUnique(t *testing.T) {
+	input := []int{5, 3, 5, 1, 3, 9, 1}
+	got := slices.Collect(UniqueLRU(slices.Values(input), 100))
+	want := slices.Collect(Unique(slices.Values(input)))
+	if !slices.Equal(got, want) {
+		t.Fatalf("UniqueLRU(_, 100) = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueByLRU(t *testing.T) {
+	got := slices.Collect(UniqueByLRU(Of("a", "A", "b", "c", "a"), strings.ToLower, 2))
+	want := []string{"a", "b", "c", "a"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("UniqueByLRU(lower, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueLRU_PanicsOnNonPositiveSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("UniqueLRU(seq, 0) did not panic, want panic")
+		}
+	}()
+	_ = UniqueLRU(Of(1), 0)
+}
+
+func TestUniqueByLRU_PanicsOnNonPositiveSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("UniqueByLRU(seq, keyFn, -1) did not panic, want panic")
+		}
+	}()
+	_ = UniqueByLRU(Of(1), func(v int) int { return v }, -1)
+}
@@ -0,0 +1,205 @@
+package itu
+
+import "iter"
+
+// Fallible streams are represented as iter.Seq2[T, error]: each pair is either
+// a value (v, nil) or a failure (zero, err). The functions in this file treat
+// the first non-nil error as the end of the stream: they forward it to the
+// consumer (or return it) and stop consuming the source.
+
+// OkErr returns a fallible iterator that yields (v, nil) for each element v in
+// seq. It never yields an error.
+//
+// Values are produced only as the returned iterator is consumed.
+func OkErr[T any](seq iter.Seq[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v := range seq {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// MapErr returns a lazy fallible iterator that yields fn(x) for each value x
+// in seq.
+//
+// If seq yields an error, or fn returns one, MapErr yields (zero, err) and
+// stops.
+//
+// Values are produced only as the returned iterator is consumed.
+func MapErr[T, R any](seq iter.Seq2[T, error], fn func(T) (R, error)) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		for v, err := range seq {
+			if err != nil {
+				var zero R
+				yield(zero, err)
+				return
+			}
+			r, err := fn(v)
+			if err != nil {
+				var zero R
+				yield(zero, err)
+				return
+			}
+			if !yield(r, nil) {
+				return
+			}
+		}
+	}
+}
+
+// FilterErr returns a lazy fallible iterator over the values of seq for which
+// pred returns true.
+//
+// If seq yields an error, FilterErr forwards it and stops. The error is never
+// passed to pred.
+//
+// Values are tested only as the returned iterator is consumed.
+func FilterErr[T any](seq iter.Seq2[T, error], pred func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v, err := range seq {
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if pred(v) {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhileErr returns a lazy fallible iterator that yields values from seq
+// while pred returns true.
+//
+// As soon as pred returns false for a value, iteration stops and that value is
+// not yielded. If seq yields an error first, TakeWhileErr forwards it and
+// stops.
+//
+// Values are tested and produced only as the returned iterator is consumed.
+func TakeWhileErr[T any](seq iter.Seq2[T, error], pred func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v, err := range seq {
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !pred(v) {
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// LiftErr applies an infallible adapter such as [Take], [Filter] or [Map] to
+// the values of a fallible stream.
+//
+// The adapter receives an iter.Seq[T] that yields the values of seq and ends at
+// the first error. LiftErr yields (r, nil) for each value r the adapter
+// produces before seq fails. Once seq has yielded an error, LiftErr yields
+// (zero, err) and stops, discarding anything the adapter would produce after
+// its input ended: for example, the partial last chunk of [Chunk] is lost.
+// This keeps adapters that go on after the end of their input, such as
+// [Cycle], from hiding the error. If the adapter consumed its input eagerly
+// (like a sort) and hit the error before producing its first value, only the
+// error is yielded.
+//
+// If the adapter stops before reaching the error, the error is not reported.
+//
+// Values are produced only as the returned iterator is consumed.
+func LiftErr[T, R any](seq iter.Seq2[T, error], adapter func(iter.Seq[T]) iter.Seq[R]) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		var failure error
+		values := func(yieldT func(T) bool) {
+			for v, err := range seq {
+				if err != nil {
+					failure = err
+					return
+				}
+				if !yieldT(v) {
+					return
+				}
+			}
+		}
+
+		for r := range adapter(values) {
+			if failure != nil {
+				break
+			}
+			if !yield(r, nil) {
+				return
+			}
+		}
+		if failure != nil {
+			var zero R
+			yield(zero, failure)
+		}
+	}
+}
+
+// FoldErr folds the values of seq from left to right, starting with acc.
+// For each value x it updates the accumulator as: acc, err = fn(acc, x).
+//
+// FoldErr consumes seq eagerly. It stops at the first error, either yielded by
+// seq or returned by fn, and returns the accumulator built so far together with
+// that error. If seq is empty, FoldErr returns acc and a nil error.
+//
+// Note: if R is a reference type (map, slice, pointer, etc.), fn may mutate the
+// accumulator value.
+func FoldErr[T, R any](seq iter.Seq2[T, error], acc R, fn func(R, T) (R, error)) (R, error) {
+	for v, err := range seq {
+		if err != nil {
+			return acc, err
+		}
+		next, err := fn(acc, v)
+		if err != nil {
+			return acc, err
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// CollectErr consumes seq eagerly and returns its values in a slice.
+//
+// CollectErr stops at the first error and returns the values collected before
+// it together with that error.
+func CollectErr[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var out []T
+	for v, err := range seq {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// ValuesErr returns an iterator over the values of seq that ends at the first
+// error, together with a function reporting that error.
+//
+// The error function reports the error that ended the most recent iteration, or
+// nil if it ended for any other reason. It is meant to be called after the
+// iteration is over, in the style of bufio.Scanner.Err.
+func ValuesErr[T any](seq iter.Seq2[T, error]) (iter.Seq[T], func() error) {
+	var failure error
+	values := func(yield func(T) bool) {
+		failure = nil
+		for v, err := range seq {
+			if err != nil {
+				failure = err
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return values, func() error { return failure }
+}
@@ -0,0 +1,91 @@
+package itu_test
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleMapErr() {
+	input := itu.OkErr(itu.Of("1", "2", "x", "4"))
+	for v, err := range itu.MapErr(input, strconv.Atoi) {
+		if err != nil {
+			fmt.Println("error:", err)
+			break
+		}
+		fmt.Println(v)
+	}
+	// Output:
+	// 1
+	// 2
+	// error: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleFilterErr() {
+	input := itu.OkErr(itu.Of(1, 2, 3, 4))
+	out, err := itu.CollectErr(itu.FilterErr(input, func(v int) bool { return v%2 == 0 }))
+	fmt.Println(out, err)
+	// Output:
+	// [2 4] <nil>
+}
+
+func ExampleTakeWhileErr() {
+	input := itu.OkErr(itu.Of(1, 2, 3, 4))
+	out, err := itu.CollectErr(itu.TakeWhileErr(input, func(v int) bool { return v < 3 }))
+	fmt.Println(out, err)
+	// Output:
+	// [1 2] <nil>
+}
+
+func ExampleLiftErr() {
+	input := func(yield func(int, error) bool) {
+		for i := range 5 {
+			if !yield(i, nil) {
+				return
+			}
+		}
+		yield(0, errors.New("connection reset"))
+	}
+
+	evens := itu.LiftErr(input, func(seq iter.Seq[int]) iter.Seq[int] {
+		return itu.Filter(seq, func(v int) bool { return v%2 == 0 })
+	})
+	out, err := itu.CollectErr(evens)
+	fmt.Println(out, err)
+	// Output:
+	// [0 2 4] connection reset
+}
+
+func ExampleFoldErr() {
+	input := itu.OkErr(itu.Of("10", "20", "30"))
+	sum, err := itu.FoldErr(input, 0, func(acc int, s string) (int, error) {
+		v, err := strconv.Atoi(s)
+		return acc + v, err
+	})
+	fmt.Println(sum, err)
+	// Output:
+	// 60 <nil>
+}
+
+func ExampleCollectErr() {
+	input := itu.MapErr(itu.OkErr(itu.Of("1", "2", "x")), strconv.Atoi)
+	out, err := itu.CollectErr(input)
+	fmt.Println(out)
+	fmt.Println(err)
+	// Output:
+	// [1 2]
+	// strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleValuesErr() {
+	input := itu.MapErr(itu.OkErr(itu.Of("1", "2", "x")), strconv.Atoi)
+	values, errFn := itu.ValuesErr(input)
+	fmt.Println(itu.Fold(values, 0, func(acc, v int) int { return acc + v }))
+	fmt.Println(errFn())
+	// Output:
+	// 3
+	// strconv.Atoi: parsing "x": invalid syntax
+}
@@ -0,0 +1,305 @@
+package itu
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+var errBoom = errors.New("boom")
+
+// failingSeq yields values and then err (if non-nil), counting produced pairs.
+func failingSeq(produced *int, err error, values ...int) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for _, v := range values {
+			*produced++
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err != nil {
+			*produced++
+			if !yield(0, err) {
+				return
+			}
+			// A well-behaved consumer stops here; anything after is a bug.
+			*produced++
+			yield(-1, nil)
+		}
+	}
+}
+
+func TestOkErr_WrapsValues(t *testing.T) {
+	got, err := CollectErr(OkErr(Of(1, 2, 3)))
+	if err != nil {
+		t.Fatalf("CollectErr(OkErr([1 2 3])) error = %v, want nil", err)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("CollectErr(OkErr([1 2 3])) = %v, want [1 2 3]", got)
+	}
+}
+
+func TestMapErr_MapsValues(t *testing.T) {
+	produced := 0
+	seq := MapErr(failingSeq(&produced, nil, 1, 2, 3), func(v int) (int, error) { return v * 10, nil })
+	got, err := CollectErr(seq)
+	if err != nil {
+		t.Fatalf("MapErr error = %v, want nil", err)
+	}
+	if !slices.Equal(got, []int{10, 20, 30}) {
+		t.Fatalf("MapErr = %v, want [10 20 30]", got)
+	}
+}
+
+func TestMapErr_StopsOnSourceError(t *testing.T) {
+	produced := 0
+	calls := 0
+	seq := MapErr(failingSeq(&produced, errBoom, 1, 2), func(v int) (int, error) {
+		calls++
+		return v, nil
+	})
+	got := collect2(seq)
+	want := []pair[int, error]{{1, nil}, {2, nil}, {0, errBoom}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MapErr = %v, want %v", got, want)
+	}
+	if calls != 2 {
+		t.Fatalf("MapErr called fn %d times, want 2", calls)
+	}
+	if produced != 3 {
+		t.Fatalf("MapErr consumed %d pairs, want 3", produced)
+	}
+}
+
+func TestMapErr_StopsOnFnError(t *testing.T) {
+	produced := 0
+	seq := MapErr(failingSeq(&produced, nil, 1, 2, 3, 4), func(v int) (string, error) {
+		if v == 2 {
+			return "", errBoom
+		}
+		return "ok", nil
+	})
+	got := collect2(seq)
+	want := []pair[string, error]{{"ok", nil}, {"", errBoom}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MapErr = %v, want %v", got, want)
+	}
+	if produced != 2 {
+		t.Fatalf("MapErr consumed %d pairs, want 2", produced)
+	}
+}
+
+func TestFilterErr_ForwardsError(t *testing.T) {
+	produced := 0
+	seq := FilterErr(failingSeq(&produced, errBoom, 1, 2, 3, 4), func(v int) bool { return v%2 == 0 })
+	got, err := CollectErr(seq)
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("FilterErr error = %v, want %v", err, errBoom)
+	}
+	if !slices.Equal(got, []int{2, 4}) {
+		t.Fatalf("FilterErr = %v, want [2 4]", got)
+	}
+	if produced != 5 {
+		t.Fatalf("FilterErr consumed %d pairs, want 5", produced)
+	}
+}
+
+func TestFilterErr_StopsWhenConsumerStops(t *testing.T) {
+	produced := 0
+	seq := FilterErr(failingSeq(&produced, errBoom, 1, 2, 3), func(int) bool { return true })
+	for range seq {
+		break
+	}
+	if produced != 1 {
+		t.Fatalf("FilterErr early break consumed %d pairs, want 1", produced)
+	}
+}
+
+func TestTakeWhileErr_StopsAtPredicate(t *testing.T) {
+	produced := 0
+	seq := TakeWhileErr(failingSeq(&produced, errBoom, 1, 2, 3), func(v int) bool { return v < 2 })
+	got, err := CollectErr(seq)
+	if err != nil {
+		t.Fatalf("TakeWhileErr error = %v, want nil", err)
+	}
+	if !slices.Equal(got, []int{1}) {
+		t.Fatalf("TakeWhileErr = %v, want [1]", got)
+	}
+	if produced != 2 {
+		t.Fatalf("TakeWhileErr consumed %d pairs, want 2", produced)
+	}
+}
+
+func TestTakeWhileErr_ForwardsError(t *testing.T) {
+	produced := 0
+	seq := TakeWhileErr(failingSeq(&produced, errBoom, 1, 2), func(int) bool { return true })
+	got, err := CollectErr(seq)
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("TakeWhileErr error = %v, want %v", err, errBoom)
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("TakeWhileErr = %v, want [1 2]", got)
+	}
+}
+
+func TestLiftErr_AppliesAdapterAndReportsError(t *testing.T) {
+	produced := 0
+	seq := LiftErr(failingSeq(&produced, errBoom, 1, 2, 3), func(s iter.Seq[int]) iter.Seq[int] {
+		return Map(s, func(v int) int { return v + 1 })
+	})
+	got := collect2(seq)
+	want := []pair[int, error]{{2, nil}, {3, nil}, {4, nil}, {0, errBoom}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("LiftErr(Map) = %v, want %v", got, want)
+	}
+	if produced != 4 {
+		t.Fatalf("LiftErr(Map) consumed %d pairs, want 4", produced)
+	}
+}
+
+func TestLiftErr_AdapterStopsBeforeError(t *testing.T) {
+	produced := 0
+	seq := LiftErr(failingSeq(&produced, errBoom, 1, 2, 3), func(s iter.Seq[int]) iter.Seq[int] {
+		return Take(s, 2)
+	})
+	got, err := CollectErr(seq)
+	if err != nil {
+		t.Fatalf("LiftErr(Take) error = %v, want nil", err)
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("LiftErr(Take) = %v, want [1 2]", got)
+	}
+	if produced != 2 {
+		t.Fatalf("LiftErr(Take) consumed %d pairs, want 2", produced)
+	}
+}
+
+func TestLiftErr_EagerAdapterReportsError(t *testing.T) {
+	produced := 0
+	seq := LiftErr(failingSeq(&produced, errBoom, 2, 1), func(s iter.Seq[int]) iter.Seq[int] {
+		return slices.Values(slices.Sorted(s))
+	})
+	got, err := CollectErr(seq)
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("LiftErr(sort) error = %v, want %v", err, errBoom)
+	}
+	if len(got) != 0 {
+		t.Fatalf("LiftErr(sort) = %v, want empty", got)
+	}
+}
+
+func TestLiftErr_DiscardsOutputAfterError(t *testing.T) {
+	produced := 0
+	seq := LiftErr(failingSeq(&produced, errBoom, 1, 2, 3), func(s iter.Seq[int]) iter.Seq[[]int] {
+		return Chunk(s, 2)
+	})
+	got, err := CollectErr(seq)
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("LiftErr(Chunk) error = %v, want %v", err, errBoom)
+	}
+	// The partial chunk [3] is only complete once the input has ended.
+	if want := [][]int{{1, 2}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("LiftErr(Chunk) = %v, want %v", got, want)
+	}
+}
+
+func TestLiftErr_InfiniteAdapterReportsError(t *testing.T) {
+	produced := 0
+	seq := LiftErr(failingSeq(&produced, errBoom, 1, 2), func(s iter.Seq[int]) iter.Seq[int] {
+		return Cycle(s)
+	})
+	got, err := CollectErr(seq)
+	if !errors.Is(err, errBoom) || !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("LiftErr(Cycle) = (%v, %v), want ([1 2], %v)", got, err, errBoom)
+	}
+}
+
+func TestLiftErr_StopsWhenConsumerStops(t *testing.T) {
+	produced := 0
+	seq := LiftErr(failingSeq(&produced, errBoom, 1, 2, 3), func(s iter.Seq[int]) iter.Seq[int] { return s })
+	for range seq {
+		break
+	}
+	if produced != 1 {
+		t.Fatalf("LiftErr early break consumed %d pairs, want 1", produced)
+	}
+}
+
+func TestFoldErr_Folds(t *testing.T) {
+	produced := 0
+	got, err := FoldErr(failingSeq(&produced, nil, 1, 2, 3), 0, func(acc, v int) (int, error) {
+		return acc + v, nil
+	})
+	if err != nil || got != 6 {
+		t.Fatalf("FoldErr = (%d, %v), want (6, nil)", got, err)
+	}
+}
+
+func TestFoldErr_ReturnsPartialAccOnSourceError(t *testing.T) {
+	produced := 0
+	got, err := FoldErr(failingSeq(&produced, errBoom, 1, 2), 0, func(acc, v int) (int, error) {
+		return acc + v, nil
+	})
+	if !errors.Is(err, errBoom) || got != 3 {
+		t.Fatalf("FoldErr = (%d, %v), want (3, %v)", got, err, errBoom)
+	}
+	if produced != 3 {
+		t.Fatalf("FoldErr consumed %d pairs, want 3", produced)
+	}
+}
+
+func TestFoldErr_StopsOnFnError(t *testing.T) {
+	produced := 0
+	got, err := FoldErr(failingSeq(&produced, nil, 1, 2, 3), 0, func(acc, v int) (int, error) {
+		if v == 2 {
+			return -1, errBoom
+		}
+		return acc + v, nil
+	})
+	if !errors.Is(err, errBoom) || got != 1 {
+		t.Fatalf("FoldErr = (%d, %v), want (1, %v)", got, err, errBoom)
+	}
+	if produced != 2 {
+		t.Fatalf("FoldErr consumed %d pairs, want 2", produced)
+	}
+}
+
+func TestCollectErr_ReturnsPrefixAndError(t *testing.T) {
+	produced := 0
+	got, err := CollectErr(failingSeq(&produced, errBoom, 1, 2))
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("CollectErr error = %v, want %v", err, errBoom)
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("CollectErr = %v, want [1 2]", got)
+	}
+	if produced != 3 {
+		t.Fatalf("CollectErr consumed %d pairs, want 3", produced)
+	}
+}
+
+func TestValuesErr_ReportsError(t *testing.T) {
+	produced := 0
+	values, errFn := ValuesErr(failingSeq(&produced, errBoom, 1, 2))
+	got := slices.Collect(values)
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("ValuesErr values = %v, want [1 2]", got)
+	}
+	if err := errFn(); !errors.Is(err, errBoom) {
+		t.Fatalf("ValuesErr error = %v, want %v", err, errBoom)
+	}
+}
+
+func TestValuesErr_NoErrorOnEarlyStop(t *testing.T) {
+	produced := 0
+	values, errFn := ValuesErr(failingSeq(&produced, errBoom, 1, 2))
+	got := slices.Collect(Take(values, 1))
+	if !slices.Equal(got, []int{1}) {
+		t.Fatalf("ValuesErr values = %v, want [1]", got)
+	}
+	if err := errFn(); err != nil {
+		t.Fatalf("ValuesErr error = %v, want nil", err)
+	}
+}
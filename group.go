@@ -0,0 +1,46 @@
+package itu
+
+import "iter"
+
+// GroupBy consumes seq eagerly and groups its elements by the key computed by
+// keyFn.
+//
+// Each group holds its elements in the order they were yielded by seq. Unlike
+// [ChunkBy], GroupBy collects all elements with the same key into one group,
+// whether or not they are adjacent. If seq is empty, GroupBy returns an empty,
+// non-nil map.
+func GroupBy[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for v := range seq {
+		k := keyFn(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// CountBy consumes seq eagerly and counts its elements by the key computed by
+// keyFn.
+//
+// If seq is empty, CountBy returns an empty, non-nil map.
+func CountBy[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for v := range seq {
+		counts[keyFn(v)]++
+	}
+	return counts
+}
+
+// Partition consumes seq eagerly and splits its elements into those for which
+// pred returns true and those for which it returns false.
+//
+// Both slices keep the order in which the elements were yielded by seq.
+func Partition[T any](seq iter.Seq[T], pred func(T) bool) (matched, rest []T) {
+	for v := range seq {
+		if pred(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
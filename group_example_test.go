@@ -0,0 +1,40 @@
+package itu_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleGroupBy() {
+	words := itu.Of("apple", "bob", "avocado", "banana", "cherry")
+	groups := itu.GroupBy(words, func(s string) byte { return s[0] })
+	for _, k := range slices.Sorted(maps.Keys(groups)) {
+		fmt.Printf("%c: %v\n", k, groups[k])
+	}
+	// Output:
+	// a: [apple avocado]
+	// b: [bob banana]
+	// c: [cherry]
+}
+
+func ExampleCountBy() {
+	statuses := itu.Of(200, 404, 200, 500, 200, 404)
+	counts := itu.CountBy(statuses, func(code int) int { return code / 100 })
+	for _, k := range slices.Sorted(maps.Keys(counts)) {
+		fmt.Printf("%dxx: %d\n", k, counts[k])
+	}
+	// Output:
+	// 2xx: 3
+	// 4xx: 2
+	// 5xx: 1
+}
+
+func ExamplePartition() {
+	even, odd := itu.Partition(itu.Range(1, 8), func(v int) bool { return v%2 == 0 })
+	fmt.Println(even, odd)
+	// Output:
+	// [2 4 6] [1 3 5 7]
+}
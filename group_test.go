@@ -0,0 +1,55 @@
+package itu
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGroupBy_GroupsNonAdjacent(t *testing.T) {
+	got := GroupBy(Of(1, 2, 3, 4, 5, 6), func(v int) int { return v % 3 })
+	want := map[int][]int{0: {3, 6}, 1: {1, 4}, 2: {2, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("GroupBy(1..6, v%%3) = %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy_Empty(t *testing.T) {
+	got := GroupBy(Empty[int](), func(v int) int { return v })
+	if got == nil || len(got) != 0 {
+		t.Fatalf("GroupBy(empty) = %#v, want empty non-nil map", got)
+	}
+}
+
+func TestCountBy_Counts(t *testing.T) {
+	got := CountBy(Of("a", "bb", "cc", "ddd", "e"), func(s string) int { return len(s) })
+	want := map[int]int{1: 2, 2: 2, 3: 1}
+	if !maps.Equal(got, want) {
+		t.Fatalf("CountBy(len) = %v, want %v", got, want)
+	}
+}
+
+func TestCountBy_Empty(t *testing.T) {
+	got := CountBy(Empty[string](), func(s string) string { return s })
+	if got == nil || len(got) != 0 {
+		t.Fatalf("CountBy(empty) = %#v, want empty non-nil map", got)
+	}
+}
+
+func TestPartition_SplitsAndKeepsOrder(t *testing.T) {
+	even, odd := Partition(Of(5, 2, 8, 1, 4, 3), func(v int) bool { return v%2 == 0 })
+	if !slices.Equal(even, []int{2, 8, 4}) {
+		t.Fatalf("Partition matched = %v, want [2 8 4]", even)
+	}
+	if !slices.Equal(odd, []int{5, 1, 3}) {
+		t.Fatalf("Partition rest = %v, want [5 1 3]", odd)
+	}
+}
+
+func TestPartition_Empty(t *testing.T) {
+	matched, rest := Partition(Empty[int](), func(int) bool { return true })
+	if len(matched) != 0 || len(rest) != 0 {
+		t.Fatalf("Partition(empty) = (%v, %v), want (empty, empty)", matched, rest)
+	}
+}
@@ -0,0 +1,38 @@
+package itutest_test
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/lymar/itu"
+	"github.com/lymar/itu/itutest"
+)
+
+// everyOther is a custom adapter under test.
+func everyOther[T any](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i%2 == 0 && !yield(v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+func TestEveryOther(t *testing.T) {
+	itutest.CheckEarlyStop(t, everyOther(itu.Range(0, 10)))
+	itutest.CheckEarlyStop2(t, itu.Enumerate(everyOther(itu.Of("a", "b", "c"))))
+}
+
+func TestItuAdapters(t *testing.T) {
+	seq := itu.Range(0, 7)
+	itutest.CheckEarlyStop(t, itu.Chunk(seq, 3))
+	itutest.CheckEarlyStop(t, itu.Windows(seq, 3, 2))
+	itutest.CheckEarlyStop(t, itu.MergeSorted(seq, itu.Range(3, 5)))
+	itutest.CheckEarlyStop(t, itu.Scan(seq, 0, func(a, b int) int { return a + b }))
+	itutest.CheckEarlyStop(t, itu.ZipN(seq, seq))
+	itutest.CheckEarlyStop2(t, itu.ZipLongest(seq, itu.Of("a"), -1, ""))
+	itutest.CheckEarlyStop(t, itu.ParallelMap(seq, 3, func(v int) int { return v * v }))
+}
@@ -0,0 +1,179 @@
+// Package itutest provides helpers for testing custom iterators and adapters
+// built on iter.Seq and iter.Seq2.
+//
+// [Check] and [Check2] wrap a sequence and report violations of the iterator
+// protocol to a testing.TB: calling yield again after it returned false,
+// calling it after the sequence returned, calling it concurrently, or
+// panicking when the consumer stops early. [CheckEarlyStop] and
+// [CheckEarlyStop2] exercise a sequence by stopping it after every possible
+// number of values.
+package itutest
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Check returns a sequence that yields the values of seq and reports to t any
+// violation of the iterator protocol by seq:
+//
+//   - yield called again after it returned false;
+//   - yield called after seq returned, for example from a leaked goroutine;
+//   - yield called while another call to it is still running;
+//   - seq panicking after yield returned false.
+//
+// Violations are reported with t.Errorf. When yield is called after it
+// returned false, the call panics to abort seq, and the panic is recovered
+// when it reaches the returned sequence. A panic from seq after an early stop
+// is recovered as well; other panics propagate unchanged.
+func Check[T any](t testing.TB, seq iter.Seq[T]) iter.Seq[T] {
+	t.Helper()
+	return func(yield func(T) bool) {
+		c := &checker{t: t}
+		defer c.finish()
+		seq(func(v T) bool {
+			if !c.enter() {
+				return false
+			}
+			ok := yield(v)
+			c.leave(ok)
+			return ok
+		})
+	}
+}
+
+// Check2 is like [Check] for an iter.Seq2.
+func Check2[K, V any](t testing.TB, seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	t.Helper()
+	return func(yield func(K, V) bool) {
+		c := &checker{t: t}
+		defer c.finish()
+		seq(func(k K, v V) bool {
+			if !c.enter() {
+				return false
+			}
+			ok := yield(k, v)
+			c.leave(ok)
+			return ok
+		})
+	}
+}
+
+// CheckEarlyStop iterates seq to the end, then iterates it again once for
+// each of its n values, stopping after 1, 2, ..., n values. Every iteration is
+// wrapped with [Check], and each stopped iteration must yield the same values
+// as the first one, compared with reflect.DeepEqual.
+//
+// seq must be finite and yield the same values each time it is iterated.
+func CheckEarlyStop[T any](t testing.TB, seq iter.Seq[T]) {
+	t.Helper()
+	var want []T
+	for v := range Check(t, seq) {
+		want = append(want, v)
+	}
+	for n := 1; n <= len(want); n++ {
+		var got []T
+		for v := range Check(t, seq) {
+			got = append(got, v)
+			if len(got) == n {
+				break
+			}
+		}
+		if !reflect.DeepEqual(got, want[:n]) {
+			t.Errorf("itutest: stopping after %d values: got %v, want %v", n, got, want[:n])
+		}
+	}
+}
+
+// CheckEarlyStop2 is like [CheckEarlyStop] for an iter.Seq2.
+func CheckEarlyStop2[K, V any](t testing.TB, seq iter.Seq2[K, V]) {
+	t.Helper()
+	var want []kv[K, V]
+	for k, v := range Check2(t, seq) {
+		want = append(want, kv[K, V]{k, v})
+	}
+	for n := 1; n <= len(want); n++ {
+		var got []kv[K, V]
+		for k, v := range Check2(t, seq) {
+			got = append(got, kv[K, V]{k, v})
+			if len(got) == n {
+				break
+			}
+		}
+		if !reflect.DeepEqual(got, want[:n]) {
+			t.Errorf("itutest: stopping after %d pairs: got %v, want %v", n, got, want[:n])
+		}
+	}
+}
+
+type kv[K, V any] struct {
+	k K
+	v V
+}
+
+func (p kv[K, V]) String() string { return fmt.Sprintf("(%v, %v)", p.k, p.v) }
+
+// abort is panicked to stop a sequence that keeps yielding after yield
+// returned false.
+type abort struct{}
+
+// checker tracks the state of one iteration. Its methods may be called from
+// several goroutines, since that is one of the things it checks for.
+type checker struct {
+	t        testing.TB
+	mu       sync.Mutex
+	inYield  bool
+	stopped  bool
+	returned bool
+}
+
+// enter is called at the start of yield and reports whether the call is
+// valid.
+func (c *checker) enter() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case c.returned:
+		c.t.Errorf("itutest: yield called after the sequence returned")
+		return false
+	case c.stopped:
+		c.t.Errorf("itutest: yield called again after it returned false")
+		panic(abort{})
+	case c.inYield:
+		c.t.Errorf("itutest: yield called concurrently")
+		return false
+	}
+	c.inYield = true
+	return true
+}
+
+// leave is called when yield returns ok.
+func (c *checker) leave(ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inYield = false
+	if !ok {
+		c.stopped = true
+	}
+}
+
+// finish is deferred by the wrapping sequence.
+func (c *checker) finish() {
+	c.mu.Lock()
+	c.returned = true
+	stopped := c.stopped
+	c.mu.Unlock()
+
+	r := recover()
+	switch {
+	case r == nil:
+	case r == abort{}:
+	case stopped:
+		c.t.Errorf("itutest: sequence panicked after yield returned false: %v", r)
+	default:
+		panic(r)
+	}
+}
@@ -0,0 +1,223 @@
+package itutest
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeTB records the errors reported to it. Methods not overridden here panic
+// through the nil embedded TB, which catches unexpected calls.
+type fakeTB struct {
+	testing.TB
+	mu   sync.Mutex
+	errs []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errs = append(f.errs, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) errors() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.errs)
+}
+
+func wantError(t *testing.T, f *fakeTB, substr string) {
+	t.Helper()
+	for _, e := range f.errors() {
+		if strings.Contains(e, substr) {
+			return
+		}
+	}
+	t.Fatalf("errors = %q, want one containing %q", f.errors(), substr)
+}
+
+func wellBehaved(yield func(int) bool) {
+	for i := range 5 {
+		if !yield(i) {
+			return
+		}
+	}
+}
+
+func ignoresStop(yield func(int) bool) {
+	for i := range 5 {
+		yield(i)
+	}
+}
+
+func infiniteIgnoresStop(yield func(int) bool) {
+	for i := 0; ; i++ {
+		yield(i)
+	}
+}
+
+func panicsOnStop(yield func(int) bool) {
+	for i := range 5 {
+		if !yield(i) {
+			panic("cleanup failed")
+		}
+	}
+}
+
+func yieldsConcurrently(yield func(int) bool) {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			yield(i)
+		}()
+	}
+	close(start)
+	wg.Wait()
+}
+
+func TestCheck_WellBehaved(t *testing.T) {
+	f := &fakeTB{}
+	got := slices.Collect(Check(f, wellBehaved))
+	if !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("Check(seq) = %v, want [0 1 2 3 4]", got)
+	}
+	CheckEarlyStop(f, wellBehaved)
+	if errs := f.errors(); len(errs) != 0 {
+		t.Fatalf("errors for a well-behaved sequence = %q, want none", errs)
+	}
+}
+
+func TestCheck_YieldAfterStop(t *testing.T) {
+	f := &fakeTB{}
+	for range Check(f, ignoresStop) {
+		break
+	}
+	wantError(t, f, "after it returned false")
+}
+
+func TestCheck_AbortsInfiniteSequence(t *testing.T) {
+	f := &fakeTB{}
+	for range Check(f, infiniteIgnoresStop) {
+		break
+	}
+	if errs := f.errors(); len(errs) != 1 {
+		t.Fatalf("errors = %q, want exactly one", errs)
+	}
+}
+
+func TestCheck_PanicOnStop(t *testing.T) {
+	f := &fakeTB{}
+	for range Check(f, panicsOnStop) {
+		break
+	}
+	wantError(t, f, "panicked after yield returned false: cleanup failed")
+}
+
+func TestCheck_OtherPanicsPropagate(t *testing.T) {
+	f := &fakeTB{}
+	defer func() {
+		if r := recover(); r != "body" {
+			t.Fatalf("recovered %v, want the loop body panic", r)
+		}
+		if errs := f.errors(); len(errs) != 0 {
+			t.Fatalf("errors = %q, want none", errs)
+		}
+	}()
+	for range Check(f, wellBehaved) {
+		panic("body")
+	}
+}
+
+func TestCheck_ConcurrentYield(t *testing.T) {
+	f := &fakeTB{}
+	// The consumer is slow so that both goroutines are in yield at once.
+	for range Check(f, yieldsConcurrently) {
+		for len(f.errors()) == 0 {
+		}
+	}
+	wantError(t, f, "concurrently")
+}
+
+func TestCheck_YieldAfterReturn(t *testing.T) {
+	f := &fakeTB{}
+	var saved func(int) bool
+	leaky := func(yield func(int) bool) { saved = yield }
+	for range Check(f, leaky) {
+	}
+	if saved(1) {
+		t.Fatalf("late yield returned true, want false")
+	}
+	wantError(t, f, "after the sequence returned")
+}
+
+func TestCheck2(t *testing.T) {
+	f := &fakeTB{}
+	seq := func(yield func(int, string) bool) {
+		yield(1, "a")
+		yield(2, "b")
+	}
+	for range Check2(f, seq) {
+		break
+	}
+	wantError(t, f, "after it returned false")
+}
+
+func TestCheckEarlyStop_FindsBugAtLaterPosition(t *testing.T) {
+	// The sequence only misbehaves when stopped at its third value.
+	buggy := func(yield func(int) bool) {
+		for i := range 5 {
+			if !yield(i) && i != 2 {
+				return
+			}
+		}
+	}
+	f := &fakeTB{}
+	CheckEarlyStop(f, buggy)
+	wantError(t, f, "after it returned false")
+}
+
+func TestCheckEarlyStop_NonDeterministic(t *testing.T) {
+	calls := 0
+	seq := func(yield func(int) bool) {
+		calls++
+		for i := range 3 {
+			if !yield(i * calls) {
+				return
+			}
+		}
+	}
+	f := &fakeTB{}
+	CheckEarlyStop(f, seq)
+	wantError(t, f, "stopping after 2 values")
+}
+
+func TestCheckEarlyStop2(t *testing.T) {
+	var seq iter.Seq2[int, string] = func(yield func(int, string) bool) {
+		for i, s := range []string{"a", "b", "c"} {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+	f := &fakeTB{}
+	CheckEarlyStop2(f, seq)
+	if errs := f.errors(); len(errs) != 0 {
+		t.Fatalf("errors = %q, want none", errs)
+	}
+
+	ignores := func(yield func(int, string) bool) {
+		yield(0, "a")
+		yield(1, "b")
+	}
+	CheckEarlyStop2(f, ignores)
+	wantError(t, f, "after it returned false")
+}
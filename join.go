@@ -0,0 +1,61 @@
+package itu
+
+import "iter"
+
+// InnerJoin returns a lazy iterator that joins the elements of left with the
+// pairs (k, r) of right whose key k equals keyFn(l), yielding a pair (l, r) for
+// every match.
+//
+// When the returned iterator is consumed, InnerJoin first consumes right
+// eagerly to build a hash index, then streams left and probes the index for
+// each element. Only right needs to fit in memory; left may be arbitrarily
+// large or infinite. For each element of left, matches are yielded in the order
+// they appeared in right. Elements of left without a match are skipped.
+func InnerJoin[L any, K comparable, R any](left iter.Seq[L], right iter.Seq2[K, R], keyFn func(L) K) iter.Seq2[L, R] {
+	return func(yield func(L, R) bool) {
+		index := buildJoinIndex(right)
+		for l := range left {
+			for _, r := range index[keyFn(l)] {
+				if !yield(l, r) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// LeftJoin is like [InnerJoin], but also yields a pair (l, zero) for each
+// element l of left that has no match in right, so every element of left
+// appears in the result at least once.
+//
+// The zero value of R cannot be told apart from a match whose value happens to
+// be zero; use a pointer or another type with a distinguishable zero value as R
+// if that matters.
+func LeftJoin[L any, K comparable, R any](left iter.Seq[L], right iter.Seq2[K, R], keyFn func(L) K) iter.Seq2[L, R] {
+	return func(yield func(L, R) bool) {
+		index := buildJoinIndex(right)
+		for l := range left {
+			matches := index[keyFn(l)]
+			if len(matches) == 0 {
+				var zero R
+				if !yield(l, zero) {
+					return
+				}
+				continue
+			}
+			for _, r := range matches {
+				if !yield(l, r) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func buildJoinIndex[K comparable, R any](right iter.Seq2[K, R]) map[K][]R {
+	index := make(map[K][]R)
+	for k, r := range right {
+		index[k] = append(index[k], r)
+	}
+	return index
+}
@@ -0,0 +1,38 @@
+package itu_test
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/lymar/itu"
+)
+
+type order struct {
+	ID     int
+	UserID int
+}
+
+func ExampleInnerJoin() {
+	orders := itu.Of(order{1, 10}, order{2, 30}, order{3, 20})
+	users := map[int]string{10: "ann", 20: "bob"}
+
+	for o, name := range itu.InnerJoin(orders, maps.All(users), func(o order) int { return o.UserID }) {
+		fmt.Println(o.ID, name)
+	}
+	// Output:
+	// 1 ann
+	// 3 bob
+}
+
+func ExampleLeftJoin() {
+	orders := itu.Of(order{1, 10}, order{2, 30}, order{3, 20})
+	users := map[int]string{10: "ann", 20: "bob"}
+
+	for o, name := range itu.LeftJoin(orders, maps.All(users), func(o order) int { return o.UserID }) {
+		fmt.Printf("%d %q\n", o.ID, name)
+	}
+	// Output:
+	// 1 "ann"
+	// 2 ""
+	// 3 "bob"
+}
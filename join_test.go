@@ -0,0 +1,86 @@
+package itu
+
+import (
+	"slices"
+	"testing"
+)
+
+type joinOrder struct {
+	id       int
+	customer string
+}
+
+func joinCustomers() func(yield func(string, string) bool) {
+	return func(yield func(string, string) bool) {
+		for _, kv := range [][2]string{{"ann", "Ann A."}, {"bob", "Bob B."}, {"ann", "Ann (alt)"}} {
+			if !yield(kv[0], kv[1]) {
+				return
+			}
+		}
+	}
+}
+
+func TestInnerJoin_YieldsAllMatches(t *testing.T) {
+	orders := Of(joinOrder{1, "ann"}, joinOrder{2, "zed"}, joinOrder{3, "bob"})
+	got := collect2(InnerJoin(orders, joinCustomers(), func(o joinOrder) string { return o.customer }))
+	want := []pair[joinOrder, string]{
+		{joinOrder{1, "ann"}, "Ann A."},
+		{joinOrder{1, "ann"}, "Ann (alt)"},
+		{joinOrder{3, "bob"}, "Bob B."},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("InnerJoin = %v, want %v", got, want)
+	}
+}
+
+func TestInnerJoin_EmptyRight(t *testing.T) {
+	got := collect2(InnerJoin(Of(1, 2), Empty2[int, string](), func(v int) int { return v }))
+	if len(got) != 0 {
+		t.Fatalf("InnerJoin(_, empty) = %v, want empty", got)
+	}
+}
+
+func TestInnerJoin_StreamsLeft(t *testing.T) {
+	right := func(yield func(int, string) bool) {
+		yield(4, "four")
+	}
+	// left is infinite; the join must still yield lazily.
+	got := collect2(Take2(InnerJoin(RangeFrom(0), right, func(v int) int { return v % 5 }), 2))
+	want := []pair[int, string]{{4, "four"}, {9, "four"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("InnerJoin(infinite left) first 2 = %v, want %v", got, want)
+	}
+}
+
+func TestLeftJoin_KeepsUnmatched(t *testing.T) {
+	orders := Of(joinOrder{1, "ann"}, joinOrder{2, "zed"}, joinOrder{3, "bob"})
+	got := collect2(LeftJoin(orders, joinCustomers(), func(o joinOrder) string { return o.customer }))
+	want := []pair[joinOrder, string]{
+		{joinOrder{1, "ann"}, "Ann A."},
+		{joinOrder{1, "ann"}, "Ann (alt)"},
+		{joinOrder{2, "zed"}, ""},
+		{joinOrder{3, "bob"}, "Bob B."},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("LeftJoin = %v, want %v", got, want)
+	}
+}
+
+func TestLeftJoin_StopsWhenConsumerStops(t *testing.T) {
+	produced := 0
+	left := func(yield func(int) bool) {
+		for i := 0; i < 10; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	got := collect2(Take2(LeftJoin(left, Empty2[int, string](), func(v int) int { return v }), 3))
+	if len(got) != 3 {
+		t.Fatalf("LeftJoin first 3 = %v, want 3 pairs", got)
+	}
+	if produced != 3 {
+		t.Fatalf("LeftJoin consumed %d values, want 3", produced)
+	}
+}
@@ -0,0 +1,115 @@
+package itu
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// DecodeJSONLines returns a fallible iterator that decodes a stream of JSON
+// values read from r, such as a JSON Lines (NDJSON) file, into values of type
+// T, one at a time.
+//
+// Values may be separated by any JSON whitespace, so blank lines are skipped.
+// Reading stops at the end of input. A decoding or read error is yielded as
+// (zero, err) and ends the iteration.
+//
+// DecodeJSONLines reads r through a single json.Decoder, so the sequence is
+// single-pass: iterating it again continues where the previous iteration
+// stopped.
+func DecodeJSONLines[T any](r io.Reader) iter.Seq2[T, error] {
+	dec := json.NewDecoder(r)
+	return func(yield func(T, error) bool) {
+		for {
+			var v T
+			err := dec.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// DecodeJSONArray returns a fallible iterator that decodes the elements of a
+// top-level JSON array read from r into values of type T, one at a time, so
+// the whole array never has to fit in memory.
+//
+// If the input does not start with '[', is truncated, or an element cannot be
+// decoded into T, the error is yielded as (zero, err) and ends the iteration.
+// Anything after the closing ']' is not decoded. The decoder reads r ahead in
+// chunks, though, so bytes following the array may already have been consumed
+// from r, and they are not accessible afterwards.
+//
+// Like [DecodeJSONLines], the sequence is single-pass.
+func DecodeJSONArray[T any](r io.Reader) iter.Seq2[T, error] {
+	dec := json.NewDecoder(r)
+	opened, closed := false, false
+	return func(yield func(T, error) bool) {
+		var zero T
+		if closed {
+			return
+		}
+		if !opened {
+			tok, err := dec.Token()
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if d, ok := tok.(json.Delim); !ok || d != '[' {
+				yield(zero, fmt.Errorf("itu: DecodeJSONArray: expected '[', found %v", tok))
+				return
+			}
+			opened = true
+		}
+
+		for dec.More() {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		// Consume the closing bracket; More reports false both for ']' and for
+		// a truncated or malformed input.
+		if _, err := dec.Token(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			yield(zero, err)
+			return
+		}
+		closed = true
+	}
+}
+
+// EncodeJSONLines writes each value of seq to w as a JSON value followed by a
+// newline, producing a JSON Lines (NDJSON) stream.
+//
+// EncodeJSONLines consumes seq eagerly and stops at the first encoding or
+// write error, which it returns.
+func EncodeJSONLines[T any](w io.Writer, seq iter.Seq[T]) error {
+	enc := json.NewEncoder(w)
+	for v := range seq {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package itu_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+type logEvent struct {
+	Level string `json:"level"`
+	Msg   string `json:"msg"`
+}
+
+func ExampleDecodeJSONLines() {
+	input := `{"level":"info","msg":"started"}
+{"level":"error","msg":"disk full"}
+{"level":"info","msg":"stopped"}
+`
+	events := itu.DecodeJSONLines[logEvent](strings.NewReader(input))
+	errs := itu.FilterErr(events, func(e logEvent) bool { return e.Level == "error" })
+	for e, err := range errs {
+		if err != nil {
+			fmt.Println("decode error:", err)
+			break
+		}
+		fmt.Println(e.Msg)
+	}
+	// Output:
+	// disk full
+}
+
+func ExampleDecodeJSONArray() {
+	input := `[{"level":"info","msg":"a"}, {"level":"warn","msg":"b"}]`
+	for e, err := range itu.DecodeJSONArray[logEvent](strings.NewReader(input)) {
+		if err != nil {
+			fmt.Println("decode error:", err)
+			break
+		}
+		fmt.Println(e.Level, e.Msg)
+	}
+	// Output:
+	// info a
+	// warn b
+}
+
+func ExampleEncodeJSONLines() {
+	events := itu.Of(logEvent{"info", "hello"}, logEvent{"warn", "world"})
+	if err := itu.EncodeJSONLines(os.Stdout, events); err != nil {
+		fmt.Println("encode error:", err)
+	}
+	// Output:
+	// {"level":"info","msg":"hello"}
+	// {"level":"warn","msg":"world"}
+}
@@ -0,0 +1,148 @@
+package itu
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type jsonEvent struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+}
+
+func TestDecodeJSONLines(t *testing.T) {
+	input := "{\"id\":1,\"kind\":\"a\"}\n\n{\"id\":2,\"kind\":\"b\"}\n"
+	got, err := CollectErr(DecodeJSONLines[jsonEvent](strings.NewReader(input)))
+	want := []jsonEvent{{1, "a"}, {2, "b"}}
+	if err != nil || !slices.Equal(got, want) {
+		t.Fatalf("DecodeJSONLines = (%v, %v), want (%v, nil)", got, err, want)
+	}
+}
+
+func TestDecodeJSONLines_Empty(t *testing.T) {
+	got, err := CollectErr(DecodeJSONLines[jsonEvent](strings.NewReader("")))
+	if err != nil || len(got) != 0 {
+		t.Fatalf("DecodeJSONLines(\"\") = (%v, %v), want (empty, nil)", got, err)
+	}
+}
+
+func TestDecodeJSONLines_StopsAtSyntaxError(t *testing.T) {
+	input := "{\"id\":1}\n{\"id\":\n{\"id\":3}\n"
+	got, err := CollectErr(DecodeJSONLines[jsonEvent](strings.NewReader(input)))
+	if err == nil {
+		t.Fatalf("DecodeJSONLines error = nil, want syntax error")
+	}
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("DecodeJSONLines before error = %v, want [{1 }]", got)
+	}
+}
+
+func TestDecodeJSONLines_ContinuesAfterBreak(t *testing.T) {
+	seq := DecodeJSONLines[int](strings.NewReader("1 2 3"))
+	for range seq {
+		break
+	}
+	got, _ := CollectErr(seq)
+	if !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("DecodeJSONLines after break = %v, want [2 3]", got)
+	}
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	input := ` [ {"id":1,"kind":"a"}, {"id":2,"kind":"b"} ] trailing garbage`
+	got, err := CollectErr(DecodeJSONArray[jsonEvent](strings.NewReader(input)))
+	want := []jsonEvent{{1, "a"}, {2, "b"}}
+	if err != nil || !slices.Equal(got, want) {
+		t.Fatalf("DecodeJSONArray = (%v, %v), want (%v, nil)", got, err, want)
+	}
+}
+
+func TestDecodeJSONArray_EmptyArray(t *testing.T) {
+	got, err := CollectErr(DecodeJSONArray[int](strings.NewReader("[]")))
+	if err != nil || len(got) != 0 {
+		t.Fatalf("DecodeJSONArray([]) = (%v, %v), want (empty, nil)", got, err)
+	}
+}
+
+func TestDecodeJSONArray_NotAnArray(t *testing.T) {
+	_, err := CollectErr(DecodeJSONArray[int](strings.NewReader(`{"a":1}`)))
+	if err == nil || !strings.Contains(err.Error(), "expected '['") {
+		t.Fatalf("DecodeJSONArray(object) error = %v, want expected '[' error", err)
+	}
+}
+
+func TestDecodeJSONArray_EmptyInput(t *testing.T) {
+	_, err := CollectErr(DecodeJSONArray[int](strings.NewReader("")))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("DecodeJSONArray(\"\") error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestDecodeJSONArray_Truncated(t *testing.T) {
+	got, err := CollectErr(DecodeJSONArray[int](strings.NewReader("[1, 2")))
+	if err == nil {
+		t.Fatalf("DecodeJSONArray(truncated) error = nil, want error")
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("DecodeJSONArray(truncated) values = %v, want [1 2]", got)
+	}
+}
+
+func TestDecodeJSONArray_TypeMismatch(t *testing.T) {
+	got, err := CollectErr(DecodeJSONArray[int](strings.NewReader(`[1, "two", 3]`)))
+	if err == nil || !slices.Equal(got, []int{1}) {
+		t.Fatalf("DecodeJSONArray(mismatch) = (%v, %v), want ([1], error)", got, err)
+	}
+}
+
+func TestDecodeJSONArray_ContinuesAfterBreak(t *testing.T) {
+	seq := DecodeJSONArray[int](strings.NewReader("[1, 2, 3]"))
+	for range seq {
+		break
+	}
+	got, err := CollectErr(seq)
+	if err != nil || !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("DecodeJSONArray after break = (%v, %v), want ([2 3], nil)", got, err)
+	}
+	if got, err := CollectErr(seq); err != nil || len(got) != 0 {
+		t.Fatalf("DecodeJSONArray after end = (%v, %v), want (empty, nil)", got, err)
+	}
+}
+
+func TestEncodeJSONLines_RoundTrip(t *testing.T) {
+	events := []jsonEvent{{1, "a"}, {2, "b"}}
+	var buf bytes.Buffer
+	if err := EncodeJSONLines(&buf, slices.Values(events)); err != nil {
+		t.Fatalf("EncodeJSONLines error = %v, want nil", err)
+	}
+	if want := "{\"id\":1,\"kind\":\"a\"}\n{\"id\":2,\"kind\":\"b\"}\n"; buf.String() != want {
+		t.Fatalf("EncodeJSONLines wrote %q, want %q", buf.String(), want)
+	}
+	got, err := CollectErr(DecodeJSONLines[jsonEvent](&buf))
+	if err != nil || !slices.Equal(got, events) {
+		t.Fatalf("DecodeJSONLines(EncodeJSONLines) = (%v, %v), want %v", got, err, events)
+	}
+}
+
+func TestEncodeJSONLines_StopsOnError(t *testing.T) {
+	produced := 0
+	seq := func(yield func(any) bool) {
+		for _, v := range []any{1, func() {}, 3} {
+			produced++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := EncodeJSONLines(&buf, seq); err == nil {
+		t.Fatalf("EncodeJSONLines(func value) error = nil, want error")
+	}
+	if produced != 2 || buf.String() != "1\n" {
+		t.Fatalf("EncodeJSONLines consumed %d values and wrote %q, want 2 and \"1\\n\"", produced, buf.String())
+	}
+}
@@ -0,0 +1,95 @@
+package itu
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"iter"
+	"strings"
+)
+
+// Lines returns a fallible iterator over the lines read from r.
+//
+// Lines are split on '\n'; the line terminator, and a '\r' right before it,
+// are not included in the yielded strings. The last line is yielded even if it
+// is not terminated, unless it is empty. Unlike bufio.Scanner, Lines has no
+// limit on the length of a line.
+//
+// If reading from r fails with an error other than io.EOF, Lines yields
+// ("", err) and stops; a partially read line is discarded.
+//
+// Lines reads r through a single buffered reader, so the sequence is
+// single-pass: iterating it again continues where the previous iteration
+// stopped.
+func Lines(r io.Reader) iter.Seq2[string, error] {
+	br := bufio.NewReader(r)
+	return func(yield func(string, error) bool) {
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield("", err)
+				return
+			}
+			if err != nil && line == "" {
+				return
+			}
+			line = strings.TrimSuffix(line, "\n")
+			line = strings.TrimSuffix(line, "\r")
+			if !yield(line, nil) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Records returns a fallible iterator over the records read from r and
+// separated by delim.
+//
+// The delimiter is not included in the yielded records. The last record is
+// yielded even if it is not terminated, unless it is empty. Each yielded slice
+// is newly allocated, so the consumer may keep it.
+//
+// If reading from r fails with an error other than io.EOF, Records yields
+// (nil, err) and stops; a partially read record is discarded.
+//
+// Like [Lines], the sequence is single-pass.
+func Records(r io.Reader, delim byte) iter.Seq2[[]byte, error] {
+	br := bufio.NewReader(r)
+	return func(yield func([]byte, error) bool) {
+		for {
+			rec, err := br.ReadBytes(delim)
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(nil, err)
+				return
+			}
+			if err != nil && len(rec) == 0 {
+				return
+			}
+			rec = bytes.TrimSuffix(rec, []byte{delim})
+			if !yield(rec, nil) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// NumberedLines returns an iterator over the lines read from r, paired with
+// their zero-based line numbers, together with a function reporting the read
+// error that ended the iteration, if any.
+//
+// The pairs are the same as [Enumerate] would produce for the lines yielded by
+// [Lines]. Iteration stops at the first read error; call the error function
+// afterwards to tell a read failure from the end of input, like
+// bufio.Scanner.Err.
+func NumberedLines(r io.Reader) (iter.Seq2[int, string], func() error) {
+	lines, errFn := ValuesErr(Lines(r))
+	return Enumerate(lines), errFn
+}
+
+// NumberedRecords is like [NumberedLines] for the records yielded by
+// [Records].
+func NumberedRecords(r io.Reader, delim byte) (iter.Seq2[int, []byte], func() error) {
+	records, errFn := ValuesErr(Records(r, delim))
+	return Enumerate(records), errFn
+}
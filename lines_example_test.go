@@ -0,0 +1,71 @@
+package itu_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+const exampleLog = `INFO start
+ERROR disk full
+INFO retry
+ERROR disk still full
+`
+
+func ExampleLines() {
+	for line, err := range itu.FilterErr(itu.Lines(strings.NewReader(exampleLog)), func(s string) bool {
+		return strings.HasPrefix(s, "ERROR")
+	}) {
+		if err != nil {
+			fmt.Println("read error:", err)
+			break
+		}
+		fmt.Println(line)
+	}
+	// Output:
+	// ERROR disk full
+	// ERROR disk still full
+}
+
+func ExampleRecords() {
+	r := strings.NewReader("alpha\x00beta\x00gamma")
+	for rec, err := range itu.Records(r, 0) {
+		if err != nil {
+			fmt.Println("read error:", err)
+			break
+		}
+		fmt.Printf("%s\n", rec)
+	}
+	// Output:
+	// alpha
+	// beta
+	// gamma
+}
+
+func ExampleNumberedLines() {
+	lines, errFn := itu.NumberedLines(strings.NewReader(exampleLog))
+	matches := itu.Filter2(lines, func(_ int, s string) bool { return strings.Contains(s, "disk") })
+	for n, line := range matches {
+		fmt.Printf("%d: %s\n", n+1, line)
+	}
+	if err := errFn(); err != nil {
+		fmt.Println("read error:", err)
+	}
+	// Output:
+	// 2: ERROR disk full
+	// 4: ERROR disk still full
+}
+
+func ExampleNumberedRecords() {
+	records, errFn := itu.NumberedRecords(strings.NewReader("a;b;c"), ';')
+	for i, rec := range records {
+		fmt.Printf("%d=%s\n", i, rec)
+	}
+	fmt.Println(errFn())
+	// Output:
+	// 0=a
+	// 1=b
+	// 2=c
+	// <nil>
+}
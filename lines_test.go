@@ -0,0 +1,137 @@
+package itu
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLines_SplitsAndTrims(t *testing.T) {
+	got, err := CollectErr(Lines(strings.NewReader("a\nb\r\n\nc")))
+	if err != nil {
+		t.Fatalf("Lines error = %v, want nil", err)
+	}
+	want := []string{"a", "b", "", "c"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Lines = %q, want %q", got, want)
+	}
+}
+
+func TestLines_TrailingNewline(t *testing.T) {
+	got, _ := CollectErr(Lines(strings.NewReader("a\nb\n")))
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Fatalf("Lines(\"a\\nb\\n\") = %q, want [a b]", got)
+	}
+}
+
+func TestLines_Empty(t *testing.T) {
+	got, err := CollectErr(Lines(strings.NewReader("")))
+	if err != nil || len(got) != 0 {
+		t.Fatalf("Lines(\"\") = (%q, %v), want (empty, nil)", got, err)
+	}
+}
+
+func TestLines_LongLine(t *testing.T) {
+	long := strings.Repeat("x", 1<<20)
+	got, err := CollectErr(Lines(strings.NewReader(long + "\nshort")))
+	if err != nil {
+		t.Fatalf("Lines(long) error = %v, want nil", err)
+	}
+	if len(got) != 2 || got[0] != long || got[1] != "short" {
+		t.Fatalf("Lines(long) returned %d lines, want the long line and \"short\"", len(got))
+	}
+}
+
+func TestLines_ReportsReadError(t *testing.T) {
+	errRead := errors.New("disk on fire")
+	r := io.MultiReader(strings.NewReader("a\nb\npartial"), iotest.ErrReader(errRead))
+	got, err := CollectErr(Lines(r))
+	if !errors.Is(err, errRead) {
+		t.Fatalf("Lines error = %v, want %v", err, errRead)
+	}
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Fatalf("Lines before error = %q, want [a b]", got)
+	}
+}
+
+func TestLines_ContinuesAfterBreak(t *testing.T) {
+	seq := Lines(strings.NewReader("1\n2\n3\n"))
+	for range seq {
+		break
+	}
+	got, _ := CollectErr(seq)
+	if !slices.Equal(got, []string{"2", "3"}) {
+		t.Fatalf("Lines after break = %q, want [2 3]", got)
+	}
+}
+
+func TestRecords_SplitsOnDelim(t *testing.T) {
+	got, err := CollectErr(Records(strings.NewReader("a\x00bc\x00\x00d"), 0))
+	if err != nil {
+		t.Fatalf("Records error = %v, want nil", err)
+	}
+	want := []string{"a", "bc", "", "d"}
+	if len(got) != len(want) {
+		t.Fatalf("Records = %q, want %q", got, want)
+	}
+	for i := range want {
+		if string(got[i]) != want[i] {
+			t.Fatalf("Records = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestRecords_RecordsAreIndependent(t *testing.T) {
+	got, _ := CollectErr(Records(strings.NewReader("ab,cd"), ','))
+	got[0][0] = 'X'
+	if string(got[1]) != "cd" {
+		t.Fatalf("Records reused backing storage: %q", got)
+	}
+}
+
+func TestRecords_ReportsReadError(t *testing.T) {
+	errRead := errors.New("broken pipe")
+	r := io.MultiReader(strings.NewReader("a;b;"), iotest.ErrReader(errRead))
+	got, err := CollectErr(Records(r, ';'))
+	if !errors.Is(err, errRead) || len(got) != 2 {
+		t.Fatalf("Records = (%q, %v), want 2 records and %v", got, err, errRead)
+	}
+}
+
+func TestNumberedLines(t *testing.T) {
+	seq, errFn := NumberedLines(strings.NewReader("x\ny\nz"))
+	got := collect2(seq)
+	want := []pair[int, string]{{0, "x"}, {1, "y"}, {2, "z"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("NumberedLines = %v, want %v", got, want)
+	}
+	if err := errFn(); err != nil {
+		t.Fatalf("NumberedLines error = %v, want nil", err)
+	}
+}
+
+func TestNumberedLines_ReportsReadError(t *testing.T) {
+	errRead := errors.New("timeout")
+	seq, errFn := NumberedLines(io.MultiReader(strings.NewReader("x\n"), iotest.ErrReader(errRead)))
+	got := collect2(seq)
+	if !slices.Equal(got, []pair[int, string]{{0, "x"}}) {
+		t.Fatalf("NumberedLines = %v, want [{0 x}]", got)
+	}
+	if err := errFn(); !errors.Is(err, errRead) {
+		t.Fatalf("NumberedLines error = %v, want %v", err, errRead)
+	}
+}
+
+func TestNumberedRecords(t *testing.T) {
+	seq, errFn := NumberedRecords(strings.NewReader("a|b"), '|')
+	var got []string
+	for i, rec := range seq {
+		got = append(got, string(rune('0'+i))+string(rec))
+	}
+	if !slices.Equal(got, []string{"0a", "1b"}) || errFn() != nil {
+		t.Fatalf("NumberedRecords = (%q, %v), want ([0a 1b], nil)", got, errFn())
+	}
+}
@@ -0,0 +1,69 @@
+package itu
+
+import (
+	"iter"
+	"math"
+)
+
+// Linspace returns a lazy iterator that yields n evenly spaced values from
+// start to end, both included. The i-th value is computed directly as
+// start + i*(end-start)/(n-1), so rounding errors do not accumulate, and the
+// last value is exactly end.
+//
+// If n == 1, Linspace yields only start. If n == 0, it yields no values.
+//
+// Linspace panics if n is negative.
+func Linspace[T Float](start, end T, n int) iter.Seq[T] {
+	if n < 0 {
+		panic("itu: Linspace: n must be non-negative")
+	}
+	return func(yield func(T) bool) {
+		for i := range n {
+			var v T
+			switch i {
+			case 0:
+				v = start
+			case n - 1:
+				v = end
+			default:
+				v = start + T(i)*(end-start)/T(n-1)
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FloatRangeBy returns a lazy iterator that counts from start towards end
+// using step, like [RangeBy] for floating-point types.
+//
+// For step > 0 it yields the values of the half-open range [start, end), and
+// for step < 0 those of (end, start], in descending order. The i-th value is
+// computed directly as start + i*step rather than by adding step repeatedly,
+// so rounding errors do not accumulate over long ranges. Because end is
+// excluded, a value that lands within rounding error below end may still be
+// yielded; use [Linspace] to hit end exactly.
+//
+// If step == 0, FloatRangeBy treats it as non-negative: it yields no values
+// when start >= end, and otherwise produces an infinite sequence of start
+// values until the consumer stops.
+//
+// If the current value overflows to an infinity, the sequence stops. If start,
+// end or step is NaN, FloatRangeBy yields no values.
+func FloatRangeBy[T Float](start, end, step T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; ; i++ {
+			v := start + T(i)*step
+			if math.IsInf(float64(v), 0) {
+				return
+			}
+			if step != step || (step >= 0 && !(v < end)) || (step < 0 && !(v > end)) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
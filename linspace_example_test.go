@@ -0,0 +1,23 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleLinspace() {
+	fmt.Println(slices.Collect(itu.Linspace(0.0, 1.0, 5)))
+	// Output:
+	// [0 0.25 0.5 0.75 1]
+}
+
+func ExampleFloatRangeBy() {
+	for v := range itu.FloatRangeBy(0.0, 0.5, 0.1) {
+		fmt.Printf("%.1f ", v)
+	}
+	fmt.Println()
+	// Output:
+	// 0.0 0.1 0.2 0.3 0.4
+}
@@ -0,0 +1,91 @@
+package itu
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestLinspace(t *testing.T) {
+	got := slices.Collect(Linspace(0.0, 1.0, 5))
+	if want := []float64{0, 0.25, 0.5, 0.75, 1}; !slices.Equal(got, want) {
+		t.Fatalf("Linspace(0, 1, 5) = %v, want %v", got, want)
+	}
+	got = slices.Collect(Linspace(1.0, -1.0, 3))
+	if want := []float64{1, 0, -1}; !slices.Equal(got, want) {
+		t.Fatalf("Linspace(1, -1, 3) = %v, want %v", got, want)
+	}
+	if got := slices.Collect(Linspace(2.0, 5.0, 1)); !slices.Equal(got, []float64{2}) {
+		t.Fatalf("Linspace(n=1) = %v, want [2]", got)
+	}
+	if got := slices.Collect(Linspace(2.0, 5.0, 0)); len(got) != 0 {
+		t.Fatalf("Linspace(n=0) = %v, want empty", got)
+	}
+}
+
+func TestLinspace_EndsExactly(t *testing.T) {
+	got := slices.Collect(Linspace(float32(0.1), 0.7, 7))
+	if len(got) != 7 || got[6] != 0.7 {
+		t.Fatalf("Linspace(0.1, 0.7, 7) = %v, want 7 values ending at 0.7", got)
+	}
+}
+
+func TestLinspace_NegativePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Linspace(n=-1) did not panic")
+		}
+	}()
+	Linspace(0.0, 1.0, -1)
+}
+
+func TestFloatRangeBy(t *testing.T) {
+	got := slices.Collect(FloatRangeBy(0.0, 1.0, 0.25))
+	if want := []float64{0, 0.25, 0.5, 0.75}; !slices.Equal(got, want) {
+		t.Fatalf("FloatRangeBy(0, 1, 0.25) = %v, want %v", got, want)
+	}
+	got = slices.Collect(FloatRangeBy(1.0, 0.0, -0.5))
+	if want := []float64{1, 0.5}; !slices.Equal(got, want) {
+		t.Fatalf("FloatRangeBy(1, 0, -0.5) = %v, want %v", got, want)
+	}
+	if got := slices.Collect(FloatRangeBy(1.0, 0.0, 0.5)); len(got) != 0 {
+		t.Fatalf("FloatRangeBy(wrong direction) = %v, want empty", got)
+	}
+}
+
+func TestFloatRangeBy_NoAccumulatedError(t *testing.T) {
+	var last float64
+	n := 0
+	for v := range FloatRangeBy(0.0, 1000.0, 0.1) {
+		last = v
+		n++
+	}
+	// Adding 0.1 repeatedly drifts by about 1e-10 after 10000 steps.
+	step := 0.1
+	if want := 9999 * step; n != 10000 || last != want {
+		t.Fatalf("FloatRangeBy(0, 1000, 0.1): %d values, last %v, want 10000, %v", n, last, want)
+	}
+}
+
+func TestFloatRangeBy_ZeroStep(t *testing.T) {
+	got := slices.Collect(Take(FloatRangeBy(1.0, 2.0, 0), 3))
+	if !slices.Equal(got, []float64{1, 1, 1}) {
+		t.Fatalf("FloatRangeBy(step=0) = %v, want [1 1 1]", got)
+	}
+	if got := slices.Collect(FloatRangeBy(2.0, 1.0, 0)); len(got) != 0 {
+		t.Fatalf("FloatRangeBy(start>end, step=0) = %v, want empty", got)
+	}
+}
+
+func TestFloatRangeBy_OverflowAndNaN(t *testing.T) {
+	got := slices.Collect(FloatRangeBy(math.MaxFloat64/2, math.Inf(1), math.MaxFloat64/2))
+	if len(got) != 2 {
+		t.Fatalf("FloatRangeBy up to overflow = %v, want 2 values", got)
+	}
+	nan := math.NaN()
+	for _, args := range [][3]float64{{nan, 1, 0.1}, {0, nan, 0.1}, {0, 1, nan}} {
+		if got := slices.Collect(FloatRangeBy(args[0], args[1], args[2])); len(got) != 0 {
+			t.Errorf("FloatRangeBy%v = %v, want empty", args, got)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package itu
+
+import "iter"
+
+// Memoize returns a sequence that yields the values of seq, caching them the
+// first time they are produced and replaying them from the cache on every
+// later iteration. This lets an expensive or single-pass sequence be consumed
+// several times, for example by [Count] and then [Equal].
+//
+// Values are pulled from seq lazily and only once: an iteration that stops
+// early leaves seq suspended, holding whatever resources it uses, and the next
+// iteration to get past the cached values resumes it. Iterations may be
+// interleaved, as in Equal(m, m). seq is released once it has been iterated to
+// the end.
+//
+// Memoize also returns a function that releases seq if it is still suspended.
+// Call it, typically with defer, when done with a memoized sequence that may
+// not have been read to the end, such as one only consumed through [Take].
+// After release, the sequence replays the values cached so far and no more.
+// release may be called more than once.
+//
+// The cache grows with the number of values produced; use [MemoizeN] to bound
+// it. The returned sequence is not safe for concurrent use from several
+// goroutines.
+func Memoize[T any](seq iter.Seq[T]) (iter.Seq[T], func()) {
+	return memoize(seq, -1)
+}
+
+// MemoizeN is like [Memoize] but caches at most limit values. It is meant
+// for sequences that can be iterated more than once: if seq produces more
+// than limit values, MemoizeN falls back to running seq again instead of
+// replaying it. The whole cache is then evicted, the iteration that is reading
+// seq goes on reading it and releases it when it ends, and every other
+// iteration, current or later, runs seq again from the start. For single-pass
+// sources, use [Memoize] or [Tee] instead.
+//
+// The returned release function is as for Memoize; once the cache has been
+// evicted, it only stops the pass that is suspended, and later iterations run
+// seq again as usual.
+//
+// MemoizeN panics if limit is negative.
+func MemoizeN[T any](seq iter.Seq[T], limit int) (iter.Seq[T], func()) {
+	if limit < 0 {
+		panic("itu: MemoizeN: limit must be non-negative")
+	}
+	return memoize(seq, limit)
+}
+
+type memoState[T any] struct {
+	seq   iter.Seq[T]
+	limit int // negative for no limit
+
+	cache    []T
+	complete bool // seq was read to the end and cache holds all its values
+	evicted  bool
+
+	// next pulls from the shared pass over seq; it is nil before the first
+	// pull and after the pass has ended. pulled counts the values it produced.
+	next   func() (T, bool)
+	stop   func()
+	pulled int
+}
+
+func memoize[T any](seq iter.Seq[T], limit int) (iter.Seq[T], func()) {
+	s := &memoState[T]{seq: seq, limit: limit}
+	return s.iterate, s.release
+}
+
+func (s *memoState[T]) iterate(yield func(T) bool) {
+	i := 0
+	defer func() {
+		// After eviction no other iteration resumes the shared pass, so
+		// the iteration at its head releases it.
+		if s.evicted && s.next != nil && i == s.pulled {
+			s.stop()
+			s.next, s.stop = nil, nil
+		}
+	}()
+	for {
+		if s.evicted && (s.next == nil || i < s.pulled) {
+			// The values this iteration needs are gone; start over.
+			for v := range Skip(s.seq, i) {
+				if !yield(v) {
+					return
+				}
+			}
+			return
+		}
+
+		if i < len(s.cache) {
+			v := s.cache[i]
+			i++
+			if !yield(v) {
+				return
+			}
+			continue
+		}
+		if s.complete {
+			return
+		}
+
+		v, ok := s.pull()
+		if !ok {
+			return
+		}
+		i++
+		if !yield(v) {
+			return
+		}
+	}
+}
+
+// release stops the shared pass if it is suspended. Without eviction the
+// cache then holds everything the sequence will yield.
+func (s *memoState[T]) release() {
+	if s.next != nil {
+		s.stop()
+		s.next, s.stop = nil, nil
+	}
+	if !s.evicted {
+		s.complete = true
+	}
+}
+
+// pull reads the next value of the shared pass and caches it.
+func (s *memoState[T]) pull() (T, bool) {
+	if s.next == nil {
+		s.next, s.stop = iter.Pull(s.seq)
+	}
+	v, ok := s.next()
+	if !ok {
+		s.stop()
+		s.next, s.stop = nil, nil
+		if !s.evicted {
+			s.complete = true
+		}
+		return v, false
+	}
+
+	s.pulled++
+	if !s.evicted {
+		s.cache = append(s.cache, v)
+		if s.limit >= 0 && len(s.cache) > s.limit {
+			s.cache = nil
+			s.evicted = true
+		}
+	}
+	return v, true
+}
@@ -0,0 +1,43 @@
+package itu_test
+
+import (
+	"fmt"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleMemoize() {
+	calls := 0
+	expensive := itu.Map(itu.Range(1, 4), func(n int) int {
+		calls++
+		return n * n
+	})
+	squares, release := itu.Memoize(expensive)
+	defer release()
+
+	fmt.Println(itu.Count(squares))
+	fmt.Println(itu.Equal(squares, itu.Of(1, 4, 9)))
+	fmt.Println("calls:", calls)
+	// Output:
+	// 3
+	// true
+	// calls: 3
+}
+
+func ExampleMemoizeN() {
+	runs := 0
+	seq := func(yield func(int) bool) {
+		runs++
+		for i := range 5 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	// Only 3 values fit in the cache, so later passes run seq again.
+	m, release := itu.MemoizeN(seq, 3)
+	defer release()
+	fmt.Println(itu.Count(m), itu.Count(m), "runs:", runs)
+	// Output:
+	// 5 5 runs: 2
+}
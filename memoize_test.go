@@ -0,0 +1,236 @@
+package itu
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+)
+
+// countingSeq yields 0..n-1 and counts the values it produces and its runs.
+func countingSeq(n int, produced, runs *int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		*runs++
+		for i := range n {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestMemoize_Replays(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := Memoize(countingSeq(4, &produced, &runs))
+	for range 3 {
+		if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2, 3}) {
+			t.Fatalf("Memoize = %v, want [0 1 2 3]", got)
+		}
+	}
+	if produced != 4 || runs != 1 {
+		t.Fatalf("Memoize produced %d values in %d runs, want 4 in 1", produced, runs)
+	}
+}
+
+func TestMemoize_Lazy(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := Memoize(countingSeq(10, &produced, &runs))
+	if produced != 0 {
+		t.Fatalf("Memoize pulled %d values before iteration, want 0", produced)
+	}
+	if got := slices.Collect(Take(m, 2)); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("Take(m, 2) = %v, want [0 1]", got)
+	}
+	if got := slices.Collect(Take(m, 4)); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("Take(m, 4) = %v, want [0 1 2 3]", got)
+	}
+	if produced != 4 || runs != 1 {
+		t.Fatalf("Memoize produced %d values in %d runs, want 4 in 1", produced, runs)
+	}
+	if got := Count(m); got != 10 || produced != 10 || runs != 1 {
+		t.Fatalf("Count(m) = %d with %d produced in %d runs, want 10, 10, 1", got, produced, runs)
+	}
+}
+
+func TestMemoize_Interleaved(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := Memoize(countingSeq(5, &produced, &runs))
+	if !Equal(m, m) {
+		t.Fatalf("Equal(m, m) = false, want true")
+	}
+	if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("Memoize after Equal = %v", got)
+	}
+	if produced != 5 || runs != 1 {
+		t.Fatalf("Memoize produced %d values in %d runs, want 5 in 1", produced, runs)
+	}
+}
+
+func TestMemoize_Nested(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := Memoize(countingSeq(3, &produced, &runs))
+	var got []int
+	for v := range m {
+		got = append(got, v, Count(m))
+	}
+	if !slices.Equal(got, []int{0, 3, 1, 3, 2, 3}) || runs != 1 {
+		t.Fatalf("nested Memoize = %v in %d runs, want [0 3 1 3 2 3] in 1", got, runs)
+	}
+}
+
+func TestMemoize_SinglePassSource(t *testing.T) {
+	produced := 0
+	m, _ := Memoize(onceSeq(3, &produced))
+	Count(m)
+	if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Memoize(single-pass) second pass = %v, want [0 1 2]", got)
+	}
+}
+
+func TestMemoizeN_WithinLimit(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := MemoizeN(countingSeq(3, &produced, &runs), 3)
+	Count(m)
+	Count(m)
+	if produced != 3 || runs != 1 {
+		t.Fatalf("MemoizeN produced %d values in %d runs, want 3 in 1", produced, runs)
+	}
+}
+
+func TestMemoizeN_Evicts(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := MemoizeN(countingSeq(5, &produced, &runs), 2)
+	if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("MemoizeN first pass = %v", got)
+	}
+	if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("MemoizeN second pass = %v", got)
+	}
+	if produced != 10 || runs != 2 {
+		t.Fatalf("MemoizeN produced %d values in %d runs, want 10 in 2", produced, runs)
+	}
+}
+
+func TestMemoizeN_EvictsDuringInterleaving(t *testing.T) {
+	produced, runs := 0, 0
+	m, _ := MemoizeN(countingSeq(5, &produced, &runs), 2)
+	got := collect2(Zip(m, m))
+	if len(got) != 5 {
+		t.Fatalf("Zip(m, m) = %v, want 5 pairs", got)
+	}
+	for _, p := range got {
+		if p.First != p.Second {
+			t.Fatalf("Zip(m, m) = %v, want equal pairs", got)
+		}
+	}
+}
+
+func TestMemoizeN_NegativePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("MemoizeN(-1) did not panic")
+		}
+	}()
+	MemoizeN(Range(0, 3), -1)
+}
+
+func TestMemoizeN_ReleasesSourceAfterEviction(t *testing.T) {
+	open := 0
+	seq := func(yield func(int) bool) {
+		open++
+		defer func() { open-- }()
+		for i := range 10 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	for range 100 {
+		m, _ := MemoizeN(seq, 2)
+		Count(Take(m, 4))
+		if open != 0 {
+			t.Fatalf("source still suspended after an early stop past the limit")
+		}
+		if got := Count(m); got != 10 {
+			t.Fatalf("Count(m) = %d, want 10", got)
+		}
+	}
+}
+
+func TestMemoizeN_NoGoroutineLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for range 100 {
+		m, _ := MemoizeN(Range(0, 10), 2)
+		Count(Take(m, 4))
+		Count(m)
+	}
+	if after := runtime.NumGoroutine(); after > before+5 {
+		t.Fatalf("goroutines grew from %d to %d", before, after)
+	}
+}
+
+func TestMemoize_Release(t *testing.T) {
+	open := 0
+	seq := func(yield func(int) bool) {
+		open++
+		defer func() { open-- }()
+		for i := range 10 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	m, release := Memoize(seq)
+	if got := slices.Collect(Take(m, 3)); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Take(m, 3) = %v, want [0 1 2]", got)
+	}
+	if open != 1 {
+		t.Fatalf("source not suspended after an early stop")
+	}
+	release()
+	if open != 0 {
+		t.Fatalf("source still suspended after release")
+	}
+	release()
+	// Only the cached values remain.
+	if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("m after release = %v, want [0 1 2]", got)
+	}
+}
+
+func TestMemoize_ReleaseDuringIteration(t *testing.T) {
+	m, release := Memoize(Range(0, 10))
+	var got []int
+	for v := range m {
+		got = append(got, v)
+		if v == 1 {
+			release()
+		}
+	}
+	if !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("m with release at 1 = %v, want [0 1]", got)
+	}
+}
+
+func TestMemoize_ReleaseNoGoroutineLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for range 100 {
+		m, release := Memoize(Range(0, 10))
+		Count(Take(m, 4))
+		release()
+	}
+	if after := runtime.NumGoroutine(); after > before+5 {
+		t.Fatalf("goroutines grew from %d to %d", before, after)
+	}
+}
+
+func TestMemoizeN_ReleaseAfterEviction(t *testing.T) {
+	produced, runs := 0, 0
+	m, release := MemoizeN(countingSeq(5, &produced, &runs), 2)
+	Count(Take(m, 3))
+	release()
+	// The cache is gone, so seq runs again in full.
+	if got := slices.Collect(m); !slices.Equal(got, []int{0, 1, 2, 3, 4}) || runs != 2 {
+		t.Fatalf("m after release = %v in %d runs, want [0 1 2 3 4] in 2", got, runs)
+	}
+}
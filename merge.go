@@ -0,0 +1,100 @@
+package itu
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+)
+
+// MergeSorted returns a lazy iterator that merges seqs, each sorted in
+// ascending order according to [cmp.Compare], into a single sorted sequence.
+//
+// Equal elements keep the order of their input sequences: an element from an
+// earlier sequence in seqs is yielded before an equal element from a later one.
+// If an input is not sorted, the order of the result is unspecified, but every
+// element of every input is still yielded exactly once.
+//
+// MergeSorted keeps one pending element per input in a heap, so it works with
+// inputs that are too large to hold in memory, including infinite ones. The
+// inputs are pulled with [iter.Pull] and stopped when the consumer stops.
+// If seqs is empty, MergeSorted yields no values.
+func MergeSorted[E cmp.Ordered](seqs ...iter.Seq[E]) iter.Seq[E] {
+	return mergeSorted(cmp.Compare[E], seqs)
+}
+
+// MergeSortedFunc returns a lazy iterator that merges seqs, each sorted in
+// ascending order according to cmpFn, into a single sorted sequence.
+//
+// cmpFn should return a negative number when a < b, a positive number when
+// a > b and zero when a == b, as in [CompareFunc].
+//
+// Equal elements keep the order of their input sequences: an element from an
+// earlier sequence in seqs is yielded before an equal element from a later one.
+// If an input is not sorted, the order of the result is unspecified, but every
+// element of every input is still yielded exactly once.
+//
+// MergeSortedFunc panics if cmpFn is nil.
+func MergeSortedFunc[E any](cmpFn func(E, E) int, seqs ...iter.Seq[E]) iter.Seq[E] {
+	if cmpFn == nil {
+		panic("itu: MergeSortedFunc cmpFn is nil")
+	}
+	return mergeSorted(cmpFn, seqs)
+}
+
+func mergeSorted[E any](cmpFn func(E, E) int, seqs []iter.Seq[E]) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		h := &mergeHeap[E]{cmp: cmpFn}
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			if v, ok := next(); ok {
+				h.items = append(h.items, mergeHead[E]{val: v, src: i, next: next})
+			}
+		}
+		heap.Init(h)
+
+		for h.Len() > 0 {
+			top := &h.items[0]
+			if !yield(top.val) {
+				return
+			}
+			if v, ok := top.next(); ok {
+				top.val = v
+				heap.Fix(h, 0)
+			} else {
+				heap.Pop(h)
+			}
+		}
+	}
+}
+
+// mergeHead is the pending element of one input of a merge.
+type mergeHead[E any] struct {
+	val  E
+	src  int
+	next func() (E, bool)
+}
+
+type mergeHeap[E any] struct {
+	items []mergeHead[E]
+	cmp   func(E, E) int
+}
+
+func (h *mergeHeap[E]) Len() int { return len(h.items) }
+
+func (h *mergeHeap[E]) Less(i, j int) bool {
+	if c := h.cmp(h.items[i].val, h.items[j].val); c != 0 {
+		return c < 0
+	}
+	return h.items[i].src < h.items[j].src
+}
+
+func (h *mergeHeap[E]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *mergeHeap[E]) Push(x any) { h.items = append(h.items, x.(mergeHead[E])) }
+
+func (h *mergeHeap[E]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
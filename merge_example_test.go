@@ -0,0 +1,28 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleMergeSorted() {
+	shard1 := slices.Values([]int{1, 4, 9})
+	shard2 := slices.Values([]int{2, 3, 10})
+	shard3 := slices.Values([]int{5})
+	fmt.Println(slices.Collect(itu.MergeSorted(shard1, shard2, shard3)))
+	// Output:
+	// [1 2 3 4 5 9 10]
+}
+
+func ExampleMergeSortedFunc() {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	short := itu.Of("a", "ccc")
+	long := itu.Of("bb", "dddd")
+	merged := itu.MergeSortedFunc(byLen, short, long)
+	fmt.Println(strings.Join(slices.Collect(merged), " "))
+	// Output:
+	// a bb ccc dddd
+}
@@ -0,0 +1,115 @@
+package itu
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMergeSorted_MergesInputs(t *testing.T) {
+	got := slices.Collect(MergeSorted(Of(1, 4, 7), Of(2, 5, 8), Of(3, 6, 9)))
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSorted = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSorted_UnevenAndEmptyInputs(t *testing.T) {
+	got := slices.Collect(MergeSorted(Empty[int](), Of(5), Of(1, 2, 3, 10), Empty[int]()))
+	want := []int{1, 2, 3, 5, 10}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSorted = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSorted_NoInputs(t *testing.T) {
+	if got := slices.Collect(MergeSorted[int]()); len(got) != 0 {
+		t.Fatalf("MergeSorted() = %v, want empty", got)
+	}
+}
+
+func TestMergeSorted_KeepsDuplicates(t *testing.T) {
+	got := slices.Collect(MergeSorted(Of(1, 2, 2), Of(2, 3)))
+	want := []int{1, 2, 2, 2, 3}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSorted = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSorted_InfiniteInputs(t *testing.T) {
+	evens := RangeFromBy(0, 2)
+	odds := RangeFromBy(1, 2)
+	got := slices.Collect(Take(MergeSorted(evens, odds), 6))
+	want := []int{0, 1, 2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSorted(evens, odds) first 6 = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSorted_StopsInputsOnEarlyBreak(t *testing.T) {
+	stopped := 0
+	produced := 0
+	src := func(values ...int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			defer func() { stopped++ }()
+			for _, v := range values {
+				produced++
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+
+	got := slices.Collect(Take(MergeSorted(src(1, 3, 5), src(2, 4, 6)), 2))
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("MergeSorted first 2 = %v, want [1 2]", got)
+	}
+	if stopped != 2 {
+		t.Fatalf("MergeSorted stopped %d inputs, want 2", stopped)
+	}
+	// One pending element per input after yielding 1 and 2.
+	if produced != 3 {
+		t.Fatalf("MergeSorted consumed %d values, want 3", produced)
+	}
+}
+
+func TestMergeSortedFunc_StableForEqualElements(t *testing.T) {
+	type item struct {
+		key int
+		src string
+	}
+	byKey := func(a, b item) int { return a.key - b.key }
+	a := Of(item{1, "a"}, item{2, "a"})
+	b := Of(item{1, "b"}, item{2, "b"})
+	c := Of(item{1, "c"})
+
+	var got []string
+	for v := range MergeSortedFunc(byKey, a, b, c) {
+		got = append(got, fmt.Sprintf("%d%s", v.key, v.src))
+	}
+	want := []string{"1a", "1b", "1c", "2a", "2b"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSortedFunc = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSortedFunc_Descending(t *testing.T) {
+	desc := func(a, b string) int { return strings.Compare(b, a) }
+	got := slices.Collect(MergeSortedFunc(desc, Of("z", "m", "a"), Of("y", "b")))
+	want := []string{"z", "y", "m", "b", "a"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("MergeSortedFunc(desc) = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSortedFunc_PanicsOnNilCmp(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("MergeSortedFunc(nil) did not panic, want panic")
+		}
+	}()
+	_ = MergeSortedFunc[int](nil, Of(1))
+}
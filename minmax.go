@@ -0,0 +1,76 @@
+package itu
+
+import (
+	"cmp"
+	"iter"
+)
+
+// MinBy returns the element of seq with the smallest key, as computed by keyFn
+// and compared with [cmp.Compare].
+//
+// MinBy consumes seq eagerly and calls keyFn once per element. If several
+// elements share the smallest key, the first one is returned. If seq is empty,
+// MinBy returns the zero value of T and ok=false.
+func MinBy[T any, K cmp.Ordered](seq iter.Seq[T], keyFn func(T) K) (value T, ok bool) {
+	var best K
+	for v := range seq {
+		k := keyFn(v)
+		if !ok || cmp.Less(k, best) {
+			value, best, ok = v, k, true
+		}
+	}
+	return value, ok
+}
+
+// MaxBy returns the element of seq with the largest key, as computed by keyFn
+// and compared with [cmp.Compare].
+//
+// MaxBy consumes seq eagerly and calls keyFn once per element. If several
+// elements share the largest key, the first one is returned. If seq is empty,
+// MaxBy returns the zero value of T and ok=false.
+func MaxBy[T any, K cmp.Ordered](seq iter.Seq[T], keyFn func(T) K) (value T, ok bool) {
+	var best K
+	for v := range seq {
+		k := keyFn(v)
+		if !ok || cmp.Less(best, k) {
+			value, best, ok = v, k, true
+		}
+	}
+	return value, ok
+}
+
+// MinMax returns the smallest and the largest elements of seq in a single
+// pass, using [cmp.Compare] to order them.
+//
+// MinMax consumes seq eagerly. If several elements compare equal to the
+// minimum or maximum, the first one is returned. If seq is empty, MinMax
+// returns zero values and ok=false.
+func MinMax[E cmp.Ordered](seq iter.Seq[E]) (minimum, maximum E, ok bool) {
+	return minMax(seq, cmp.Compare[E])
+}
+
+// MinMaxFunc is like [MinMax] but orders the elements with cmpFn.
+//
+// MinMaxFunc panics if cmpFn is nil.
+func MinMaxFunc[E any](seq iter.Seq[E], cmpFn func(E, E) int) (minimum, maximum E, ok bool) {
+	if cmpFn == nil {
+		panic("itu: MinMaxFunc cmpFn is nil")
+	}
+	return minMax(seq, cmpFn)
+}
+
+func minMax[E any](seq iter.Seq[E], cmpFn func(E, E) int) (minimum, maximum E, ok bool) {
+	for v := range seq {
+		if !ok {
+			minimum, maximum, ok = v, v, true
+			continue
+		}
+		if cmpFn(v, minimum) < 0 {
+			minimum = v
+		}
+		if cmpFn(v, maximum) > 0 {
+			maximum = v
+		}
+	}
+	return minimum, maximum, ok
+}
@@ -0,0 +1,40 @@
+package itu_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleMinBy() {
+	words := itu.Of("banana", "fig", "cherry", "kiwi")
+	shortest, ok := itu.MinBy(words, func(s string) int { return len(s) })
+	fmt.Println(shortest, ok)
+	// Output:
+	// fig true
+}
+
+func ExampleMaxBy() {
+	words := itu.Of("banana", "fig", "cherry", "kiwi")
+	longest, ok := itu.MaxBy(words, func(s string) int { return len(s) })
+	fmt.Println(longest, ok)
+	// Output:
+	// banana true
+}
+
+func ExampleMinMax() {
+	lo, hi, ok := itu.MinMax(itu.Of(3, 9, -4, 7))
+	fmt.Println(lo, hi, ok)
+	// Output:
+	// -4 9 true
+}
+
+func ExampleMinMaxFunc() {
+	lo, hi, ok := itu.MinMaxFunc(itu.Of("b", "C", "a"), func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	})
+	fmt.Println(lo, hi, ok)
+	// Output:
+	// a C true
+}
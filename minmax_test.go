@@ -0,0 +1,82 @@
+package itu
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestMinBy_ReturnsFirstSmallest(t *testing.T) {
+	got, ok := MinBy(Of("ccc", "a", "bb", "d"), func(s string) int { return len(s) })
+	if !ok || got != "a" {
+		t.Fatalf("MinBy(len) = (%q, %v), want (\"a\", true)", got, ok)
+	}
+}
+
+func TestMaxBy_ReturnsFirstLargest(t *testing.T) {
+	got, ok := MaxBy(Of("a", "ccc", "bb", "ddd"), func(s string) int { return len(s) })
+	if !ok || got != "ccc" {
+		t.Fatalf("MaxBy(len) = (%q, %v), want (\"ccc\", true)", got, ok)
+	}
+}
+
+func TestMinByMaxBy_Empty(t *testing.T) {
+	if v, ok := MinBy(Empty[string](), strings.ToLower); ok || v != "" {
+		t.Fatalf("MinBy(empty) = (%q, %v), want (\"\", false)", v, ok)
+	}
+	if v, ok := MaxBy(Empty[string](), strings.ToLower); ok || v != "" {
+		t.Fatalf("MaxBy(empty) = (%q, %v), want (\"\", false)", v, ok)
+	}
+}
+
+func TestMinBy_CallsKeyFnOncePerElement(t *testing.T) {
+	calls := 0
+	_, _ = MinBy(Of(3, 1, 2), func(v int) int {
+		calls++
+		return v
+	})
+	if calls != 3 {
+		t.Fatalf("MinBy called keyFn %d times, want 3", calls)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	lo, hi, ok := MinMax(Of(4, -2, 9, 0, 9, -2))
+	if !ok || lo != -2 || hi != 9 {
+		t.Fatalf("MinMax = (%d, %d, %v), want (-2, 9, true)", lo, hi, ok)
+	}
+
+	lo, hi, ok = MinMax(Of(7))
+	if !ok || lo != 7 || hi != 7 {
+		t.Fatalf("MinMax([7]) = (%d, %d, %v), want (7, 7, true)", lo, hi, ok)
+	}
+
+	_, _, ok = MinMax(Empty[int]())
+	if ok {
+		t.Fatalf("MinMax(empty) ok = true, want false")
+	}
+}
+
+func TestMinMax_NaNIsSmallest(t *testing.T) {
+	lo, hi, _ := MinMax(Of(1.0, math.NaN(), 2.0))
+	if !math.IsNaN(lo) || hi != 2 {
+		t.Fatalf("MinMax([1 NaN 2]) = (%v, %v), want (NaN, 2)", lo, hi)
+	}
+}
+
+func TestMinMaxFunc_FirstOfEqual(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	lo, hi, ok := MinMaxFunc(Of("bb", "a", "c", "dd"), byLen)
+	if !ok || lo != "a" || hi != "bb" {
+		t.Fatalf("MinMaxFunc(len) = (%q, %q, %v), want (\"a\", \"bb\", true)", lo, hi, ok)
+	}
+}
+
+func TestMinMaxFunc_PanicsOnNilCmp(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("MinMaxFunc(nil) did not panic, want panic")
+		}
+	}()
+	_, _, _ = MinMaxFunc(Of(1), nil)
+}
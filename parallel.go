@@ -0,0 +1,173 @@
+package itu
+
+import (
+	"iter"
+	"sync"
+)
+
+type parallelJob[T any] struct {
+	idx int
+	val T
+}
+
+type parallelResult[R any] struct {
+	idx      int
+	val      R
+	panicked bool
+	panicVal any
+}
+
+// ParallelMap returns a lazy iterator that yields fn(x) for each element x in
+// seq, calling fn on up to workers goroutines at once. Results are yielded in
+// the order of the corresponding elements of seq.
+//
+// seq is iterated on a separate goroutine. At most 2*workers elements are in
+// flight at any time (being processed by fn or waiting in the reorder buffer
+// for an earlier result), so a slow element holds back the pipeline rather than
+// growing memory without bound.
+//
+// When the consumer stops early, ParallelMap stops pulling from seq and waits
+// for all of its goroutines, including calls to fn that are already running,
+// to return before the range loop ends. If fn or seq panics, the panic is
+// re-raised on the consumer's goroutine.
+//
+// ParallelMap panics if workers is not positive.
+func ParallelMap[T, R any](seq iter.Seq[T], workers int, fn func(T) R) iter.Seq[R] {
+	if workers <= 0 {
+		panic("itu: ParallelMap: workers must be positive")
+	}
+	return parallelMap(seq, workers, fn, true)
+}
+
+// ParallelMapUnordered returns a lazy iterator that yields fn(x) for each
+// element x in seq, calling fn on up to workers goroutines at once. Results are
+// yielded as soon as they are ready, in no particular order.
+//
+// seq is iterated on a separate goroutine. At most 2*workers elements are in
+// flight at any time.
+//
+// When the consumer stops early, ParallelMapUnordered stops pulling from seq
+// and waits for all of its goroutines, including calls to fn that are already
+// running, to return before the range loop ends. If fn or seq panics, the panic
+// is re-raised on the consumer's goroutine.
+//
+// ParallelMapUnordered panics if workers is not positive.
+func ParallelMapUnordered[T, R any](seq iter.Seq[T], workers int, fn func(T) R) iter.Seq[R] {
+	if workers <= 0 {
+		panic("itu: ParallelMapUnordered: workers must be positive")
+	}
+	return parallelMap(seq, workers, fn, false)
+}
+
+func parallelMap[T, R any](seq iter.Seq[T], workers int, fn func(T) R, ordered bool) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		done := make(chan struct{})
+		tokens := make(chan struct{}, 2*workers)
+		jobs := make(chan parallelJob[T])
+		results := make(chan parallelResult[R], workers)
+
+		var wg sync.WaitGroup
+		defer func() {
+			close(done)
+			wg.Wait()
+		}()
+
+		send := func(r parallelResult[R]) bool {
+			select {
+			case results <- r:
+				return true
+			case <-done:
+				return false
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+			defer func() {
+				if p := recover(); p != nil {
+					send(parallelResult[R]{panicked: true, panicVal: p})
+				}
+			}()
+
+			i := 0
+			for v := range seq {
+				select {
+				case tokens <- struct{}{}:
+				case <-done:
+					return
+				}
+				select {
+				case jobs <- parallelJob[T]{idx: i, val: v}:
+				case <-done:
+					return
+				}
+				i++
+			}
+		}()
+
+		var workersWG sync.WaitGroup
+		for range workers {
+			workersWG.Add(1)
+			go func() {
+				defer workersWG.Done()
+				for job := range jobs {
+					if !send(callParallel(fn, job)) {
+						return
+					}
+				}
+			}()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workersWG.Wait()
+			close(results)
+		}()
+
+		if !ordered {
+			for r := range results {
+				if r.panicked {
+					panic(r.panicVal)
+				}
+				<-tokens
+				if !yield(r.val) {
+					return
+				}
+			}
+			return
+		}
+
+		pending := make(map[int]R)
+		next := 0
+		for r := range results {
+			if r.panicked {
+				panic(r.panicVal)
+			}
+			pending[r.idx] = r.val
+			for {
+				v, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				<-tokens
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func callParallel[T, R any](fn func(T) R, job parallelJob[T]) (r parallelResult[R]) {
+	defer func() {
+		if p := recover(); p != nil {
+			r = parallelResult[R]{idx: job.idx, panicked: true, panicVal: p}
+		}
+	}()
+	return parallelResult[R]{idx: job.idx, val: fn(job.val)}
+}
@@ -0,0 +1,25 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleParallelMap() {
+	words := itu.Of("alpha", "beta", "gamma", "delta")
+	upper := itu.ParallelMap(words, 2, strings.ToUpper)
+	fmt.Println(slices.Collect(upper))
+	// Output:
+	// [ALPHA BETA GAMMA DELTA]
+}
+
+func ExampleParallelMapUnordered() {
+	squares := itu.ParallelMapUnordered(itu.Range(1, 6), 3, func(v int) int { return v * v })
+	// Results arrive in completion order; sort them for a stable output.
+	fmt.Println(slices.Sorted(squares))
+	// Output:
+	// [1 4 9 16 25]
+}
@@ -0,0 +1,192 @@
+package itu
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap_PreservesOrder(t *testing.T) {
+	got := slices.Collect(ParallelMap(Range(0, 50), 4, func(v int) int {
+		// Later elements finish first.
+		time.Sleep(time.Duration(50-v) * 50 * time.Microsecond)
+		return v * 2
+	}))
+	want := slices.Collect(Map(Range(0, 50), func(v int) int { return v * 2 }))
+	if !slices.Equal(got, want) {
+		t.Fatalf("ParallelMap = %v, want %v", got, want)
+	}
+}
+
+func TestParallelMapUnordered_YieldsAll(t *testing.T) {
+	got := slices.Collect(ParallelMapUnordered(Range(0, 50), 4, func(v int) int { return v * 2 }))
+	slices.Sort(got)
+	want := slices.Collect(Map(Range(0, 50), func(v int) int { return v * 2 }))
+	if !slices.Equal(got, want) {
+		t.Fatalf("sorted ParallelMapUnordered = %v, want %v", got, want)
+	}
+}
+
+func TestParallelMap_Empty(t *testing.T) {
+	if got := slices.Collect(ParallelMap(Empty[int](), 3, func(v int) int { return v })); len(got) != 0 {
+		t.Fatalf("ParallelMap(empty) = %v, want empty", got)
+	}
+	if got := slices.Collect(ParallelMapUnordered(Empty[int](), 3, func(v int) int { return v })); len(got) != 0 {
+		t.Fatalf("ParallelMapUnordered(empty) = %v, want empty", got)
+	}
+}
+
+func TestParallelMap_RunsConcurrently(t *testing.T) {
+	const workers = 3
+	var active atomic.Int32
+	allStarted := make(chan struct{})
+	var once sync.Once
+
+	fn := func(v int) int {
+		if active.Add(1) == workers {
+			once.Do(func() { close(allStarted) })
+		}
+		select {
+		case <-allStarted:
+		case <-time.After(5 * time.Second):
+			t.Errorf("fn(%d): only %d of %d workers became active", v, active.Load(), workers)
+		}
+		return v
+	}
+
+	got := slices.Collect(ParallelMap(Range(0, workers), workers, fn))
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("ParallelMap = %v, want [0 1 2]", got)
+	}
+}
+
+func TestParallelMap_BoundsInFlight(t *testing.T) {
+	const workers = 2
+	var produced atomic.Int32
+	seq := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			produced.Add(1)
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	block := make(chan struct{})
+	var producedWhileBlocked int32
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		producedWhileBlocked = produced.Load()
+		close(block)
+	}()
+
+	for v := range ParallelMap(seq, workers, func(v int) int {
+		if v == 0 {
+			<-block
+		}
+		return v
+	}) {
+		if v != 0 {
+			t.Fatalf("ParallelMap first value = %d, want 0", v)
+		}
+		break
+	}
+
+	// Element 0 blocks the reorder buffer, so at most 2*workers elements may be
+	// dispatched, plus one pulled element waiting for a free slot.
+	if n := producedWhileBlocked; n > 2*workers+1 {
+		t.Fatalf("ParallelMap pulled %d elements while blocked, want <= %d", n, 2*workers+1)
+	}
+}
+
+func TestParallelMap_EarlyBreakWaitsForGoroutines(t *testing.T) {
+	for _, ordered := range []bool{true, false} {
+		var active atomic.Int32
+		var sourceDone atomic.Bool
+		seq := func(yield func(int) bool) {
+			defer sourceDone.Store(true)
+			for i := 0; ; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		fn := func(v int) int {
+			active.Add(1)
+			defer active.Add(-1)
+			time.Sleep(time.Millisecond)
+			return v
+		}
+
+		mapped := ParallelMap(seq, 4, fn)
+		if !ordered {
+			mapped = ParallelMapUnordered(seq, 4, fn)
+		}
+
+		n := 0
+		for range mapped {
+			n++
+			if n == 5 {
+				break
+			}
+		}
+
+		if a := active.Load(); a != 0 {
+			t.Fatalf("ordered=%v: %d calls to fn still running after break, want 0", ordered, a)
+		}
+		if !sourceDone.Load() {
+			t.Fatalf("ordered=%v: source still running after break", ordered)
+		}
+	}
+}
+
+func TestParallelMap_PropagatesFnPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "bad value" {
+			t.Fatalf("ParallelMap recovered %v, want %q", r, "bad value")
+		}
+	}()
+	for range ParallelMap(Range(0, 10), 2, func(v int) int {
+		if v == 3 {
+			panic("bad value")
+		}
+		return v
+	}) {
+	}
+	t.Fatalf("ParallelMap did not panic")
+}
+
+func TestParallelMapUnordered_PropagatesSourcePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "bad source" {
+			t.Fatalf("ParallelMapUnordered recovered %v, want %q", r, "bad source")
+		}
+	}()
+	seq := func(yield func(int) bool) {
+		yield(1)
+		panic("bad source")
+	}
+	for range ParallelMapUnordered(seq, 2, func(v int) int { return v }) {
+	}
+	t.Fatalf("ParallelMapUnordered did not panic")
+}
+
+func TestParallelMap_PanicsOnNonPositiveWorkers(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("ParallelMap(seq, 0, fn) did not panic, want panic")
+		}
+	}()
+	_ = ParallelMap(Of(1), 0, func(v int) int { return v })
+}
+
+func TestParallelMapUnordered_PanicsOnNonPositiveWorkers(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("ParallelMapUnordered(seq, -1, fn) did not panic, want panic")
+		}
+	}()
+	_ = ParallelMapUnordered(Of(1), -1, func(v int) int { return v })
+}
@@ -0,0 +1,169 @@
+package itu
+
+import (
+	"iter"
+	"slices"
+)
+
+// Percentile returns an estimate of the p-th percentile of the values in seq,
+// for p in [0, 100]. For example, Percentile(latencies, 99) estimates the p99
+// latency. If seq is empty, it returns (0, false).
+//
+// Percentile consumes seq eagerly in a single pass and in constant memory,
+// using the P² algorithm of Jain and Chlamtac. The result is exact for
+// sequences of up to 5 values and an approximation otherwise; it is usually
+// close for large sequences of smoothly distributed values, but no error
+// bound is guaranteed.
+//
+// Percentile panics if p is not in [0, 100].
+func Percentile[T Number](seq iter.Seq[T], p float64) (float64, bool) {
+	res, ok := Percentiles(seq, p)
+	if !ok {
+		return 0, false
+	}
+	return res[0], true
+}
+
+// Percentiles is like [Percentile] but estimates several percentiles in the
+// same pass over seq. The result holds one estimate per element of ps, in the
+// same order. If seq is empty, it returns (nil, false).
+//
+// Percentiles panics if any element of ps is not in [0, 100].
+func Percentiles[T Number](seq iter.Seq[T], ps ...float64) ([]float64, bool) {
+	ests := make([]p2Estimator, len(ps))
+	for i, p := range ps {
+		if !(p >= 0 && p <= 100) {
+			panic("itu: Percentiles: percentile out of range [0, 100]")
+		}
+		ests[i].p = p / 100
+	}
+
+	// The first values are kept to initialize the estimators, and to compute
+	// exact results for short sequences.
+	var first []float64
+	count := 0
+	for v := range seq {
+		x := float64(v)
+		count++
+		if count <= p2Markers {
+			first = append(first, x)
+			if len(first) == p2Markers {
+				for i := range ests {
+					ests[i].init(first)
+				}
+			}
+			continue
+		}
+		for i := range ests {
+			ests[i].add(x)
+		}
+	}
+
+	if count == 0 {
+		return nil, false
+	}
+	res := make([]float64, len(ests))
+	if count <= p2Markers {
+		slices.Sort(first)
+		for i, e := range ests {
+			res[i] = exactQuantile(first, e.p)
+		}
+		return res, true
+	}
+	for i, e := range ests {
+		res[i] = e.value()
+	}
+	return res, true
+}
+
+// exactQuantile returns the q-th quantile of sorted, interpolating linearly
+// between the closest ranks.
+func exactQuantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(pos)
+	if lo == len(sorted)-1 {
+		return sorted[lo]
+	}
+	return sorted[lo] + (pos-float64(lo))*(sorted[lo+1]-sorted[lo])
+}
+
+const p2Markers = 5
+
+// p2Estimator estimates a single quantile with the P² algorithm. It tracks
+// five markers: the minimum, the maximum, the estimated quantile in the middle,
+// and two halfway between.
+type p2Estimator struct {
+	p  float64
+	q  [p2Markers]float64 // marker heights
+	n  [p2Markers]float64 // actual marker positions
+	np [p2Markers]float64 // desired marker positions
+	dn [p2Markers]float64 // desired position increments
+}
+
+func (e *p2Estimator) init(first []float64) {
+	copy(e.q[:], first)
+	slices.Sort(e.q[:])
+	p := e.p
+	e.n = [p2Markers]float64{0, 1, 2, 3, 4}
+	e.np = [p2Markers]float64{0, 2 * p, 4 * p, 2 + 2*p, 4}
+	e.dn = [p2Markers]float64{0, p / 2, p, (1 + p) / 2, 1}
+}
+
+func (e *p2Estimator) add(x float64) {
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x >= e.q[4]:
+		e.q[4] = x
+		k = 3
+	default:
+		for k = 0; x >= e.q[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < p2Markers; i++ {
+		e.n[i]++
+	}
+	for i := range e.np {
+		e.np[i] += e.dn[i]
+	}
+
+	for i := 1; i < p2Markers-1; i++ {
+		d := e.np[i] - e.n[i]
+		if (d >= 1 && e.n[i+1]-e.n[i] > 1) || (d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			s := 1.0
+			if d < 0 {
+				s = -1
+			}
+			q := e.parabolic(i, s)
+			if !(e.q[i-1] < q && q < e.q[i+1]) {
+				q = e.linear(i, s)
+			}
+			e.q[i] = q
+			e.n[i] += s
+		}
+	}
+}
+
+func (e *p2Estimator) value() float64 {
+	// The outer markers track the extremes exactly.
+	switch e.p {
+	case 0:
+		return e.q[0]
+	case 1:
+		return e.q[4]
+	}
+	return e.q[2]
+}
+
+func (e *p2Estimator) parabolic(i int, s float64) float64 {
+	return e.q[i] + s/(e.n[i+1]-e.n[i-1])*
+		((e.n[i]-e.n[i-1]+s)*(e.q[i+1]-e.q[i])/(e.n[i+1]-e.n[i])+
+			(e.n[i+1]-e.n[i]-s)*(e.q[i]-e.q[i-1])/(e.n[i]-e.n[i-1]))
+}
+
+func (e *p2Estimator) linear(i int, s float64) float64 {
+	j := i + int(s)
+	return e.q[i] + s*(e.q[j]-e.q[i])/(e.n[j]-e.n[i])
+}
@@ -0,0 +1,24 @@
+package itu_test
+
+import (
+	"fmt"
+
+	"github.com/lymar/itu"
+)
+
+func ExamplePercentile() {
+	latencies := itu.Of(12, 15, 11, 90)
+	p50, _ := itu.Percentile(latencies, 50)
+	fmt.Println(p50)
+	// Output:
+	// 13.5
+}
+
+func ExamplePercentiles() {
+	// Latencies 1ms..1000ms; estimated in one pass without storing them.
+	latencies := itu.RangeInclusive(1, 1000)
+	ps, _ := itu.Percentiles(latencies, 50, 99)
+	fmt.Printf("p50≈%.0f p99≈%.0f\n", ps[0], ps[1])
+	// Output:
+	// p50≈500 p99≈990
+}
@@ -0,0 +1,83 @@
+package itu
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestPercentile_Small(t *testing.T) {
+	tests := []struct {
+		in   []int
+		p    float64
+		want float64
+	}{
+		{[]int{7}, 50, 7},
+		{[]int{3, 1}, 50, 2},
+		{[]int{5, 1, 4, 2, 3}, 50, 3},
+		{[]int{5, 1, 4, 2, 3}, 0, 1},
+		{[]int{5, 1, 4, 2, 3}, 100, 5},
+		{[]int{5, 1, 4, 2, 3}, 90, 4.6},
+	}
+	for _, tt := range tests {
+		got, ok := Percentile(slices.Values(tt.in), tt.p)
+		if !ok || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Percentile(%v, %v) = (%v, %v), want (%v, true)", tt.in, tt.p, got, ok, tt.want)
+		}
+	}
+}
+
+func TestPercentile_Empty(t *testing.T) {
+	if got, ok := Percentile(Empty[int](), 50); ok || got != 0 {
+		t.Fatalf("Percentile(empty) = (%v, %v), want (0, false)", got, ok)
+	}
+	if got, ok := Percentiles(Empty[int](), 50, 99); ok || got != nil {
+		t.Fatalf("Percentiles(empty) = (%v, %v), want (nil, false)", got, ok)
+	}
+}
+
+func TestPercentiles_Approximation(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	data := make([]float64, 100_000)
+	for i := range data {
+		data[i] = rng.ExpFloat64() * 100
+	}
+	ps := []float64{0, 10, 50, 90, 99, 100}
+	got, ok := Percentiles(slices.Values(data), ps...)
+	if !ok || len(got) != len(ps) {
+		t.Fatalf("Percentiles = (%v, %v), want %d values", got, ok, len(ps))
+	}
+
+	sorted := slices.Sorted(slices.Values(data))
+	for i, p := range ps {
+		want := exactQuantile(sorted, p/100)
+		if math.Abs(got[i]-want) > 0.02*want+0.5 {
+			t.Errorf("Percentiles p%v = %v, want about %v", p, got[i], want)
+		}
+	}
+	if got[0] != sorted[0] || got[len(got)-1] != sorted[len(sorted)-1] {
+		t.Errorf("Percentiles p0, p100 = %v, %v, want exact %v, %v",
+			got[0], got[len(got)-1], sorted[0], sorted[len(sorted)-1])
+	}
+}
+
+func TestPercentiles_SortedInput(t *testing.T) {
+	got, _ := Percentiles(Range(0, 10_001), 50, 99)
+	if math.Abs(got[0]-5000) > 50 || math.Abs(got[1]-9900) > 50 {
+		t.Fatalf("Percentiles(0..10000) = %v, want about [5000 9900]", got)
+	}
+}
+
+func TestPercentiles_OutOfRangePanics(t *testing.T) {
+	for _, p := range []float64{-1, 100.5, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Percentiles(%v) did not panic", p)
+				}
+			}()
+			Percentiles(Of(1), 50, p)
+		}()
+	}
+}
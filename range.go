@@ -7,6 +7,16 @@ type Integer interface {
 		~uint32 | ~uint64 | ~uintptr
 }
 
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
 func overflowingAdd[T Integer](a, b T) (T, bool) {
 	c := a + b
 	overflow := (b > 0 && c < a) || (b < 0 && c > a)
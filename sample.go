@@ -0,0 +1,122 @@
+package itu
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"math/rand/v2"
+)
+
+// Sample consumes seq eagerly and returns a uniform random sample of k of its
+// elements, using reservoir sampling: every element of seq has the same
+// probability of being selected, and only the k sampled elements are kept in
+// memory, so seq may be arbitrarily long. If seq yields fewer than k elements,
+// all of them are returned. The order of the returned elements is unspecified.
+//
+// Random numbers are drawn from rng, so a seeded rng gives reproducible
+// results. If rng is nil, the top-level functions of math/rand/v2 are used.
+//
+// If k is zero, Sample returns nil without consuming seq.
+//
+// Sample panics if k is negative.
+func Sample[T any](seq iter.Seq[T], k int, rng *rand.Rand) []T {
+	if k < 0 {
+		panic("itu: Sample: k must be non-negative")
+	}
+	if k == 0 {
+		return nil
+	}
+
+	res := make([]T, 0, min(k, 64))
+	i := 0
+	for v := range seq {
+		if i < k {
+			res = append(res, v)
+		} else if j := randIntN(rng, i+1); j < k {
+			res[j] = v
+		}
+		i++
+	}
+	return res
+}
+
+// Bernoulli returns a lazy iterator that yields each element of seq
+// independently with probability p, for example to keep about 1% of traffic
+// with p = 0.01.
+//
+// Random numbers are drawn from rng, so a seeded rng gives reproducible
+// results. If rng is nil, the top-level functions of math/rand/v2 are used.
+// Iterating the sequence several times draws new random numbers each time.
+//
+// Bernoulli panics if p is not in [0, 1].
+func Bernoulli[T any](seq iter.Seq[T], p float64, rng *rand.Rand) iter.Seq[T] {
+	if !(p >= 0 && p <= 1) {
+		panic("itu: Bernoulli: p must be in [0, 1]")
+	}
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if randFloat64(rng) < p {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// WeightedSample is like [Sample], but each element is selected with a
+// probability proportional to its weight, as reported by weightFn. Elements
+// with a zero or negative weight are never selected, so the result may hold
+// fewer than k elements.
+//
+// WeightedSample samples without replacement using the algorithm of
+// Efraimidis and Spirakis, in O(k) memory.
+//
+// WeightedSample panics if k is negative or weightFn is nil.
+func WeightedSample[T any](seq iter.Seq[T], k int, weightFn func(T) float64, rng *rand.Rand) []T {
+	if k < 0 {
+		panic("itu: WeightedSample: k must be non-negative")
+	}
+	if weightFn == nil {
+		panic("itu: WeightedSample weightFn is nil")
+	}
+
+	// Each element gets the key u^(1/w) for a uniform u in (0, 1); the sample is
+	// the k elements with the largest keys. Logarithms keep the keys from
+	// underflowing to zero for small weights.
+	keyed := func(yield func(pair[float64, T]) bool) {
+		for v := range seq {
+			w := weightFn(v)
+			if !(w > 0) {
+				continue
+			}
+			u := 1 - randFloat64(rng) // in (0, 1]
+			if !yield(pair[float64, T]{First: math.Log(u) / w, Second: v}) {
+				return
+			}
+		}
+	}
+	top := selectK(keyed, k, func(a, b pair[float64, T]) int { return cmp.Compare(a.First, b.First) })
+	if top == nil {
+		return nil
+	}
+	res := make([]T, len(top))
+	for i, p := range top {
+		res[i] = p.Second
+	}
+	return res
+}
+
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
@@ -0,0 +1,44 @@
+package itu_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleSample() {
+	rng := rand.New(rand.NewPCG(1, 2))
+	sample := itu.Sample(itu.Range(0, 1_000_000), 3, rng)
+	fmt.Println(len(sample))
+	// Output:
+	// 3
+}
+
+func ExampleBernoulli() {
+	rng := rand.New(rand.NewPCG(1, 2))
+	kept := itu.Count(itu.Bernoulli(itu.Range(0, 10_000), 0.01, rng))
+	fmt.Println(kept > 50 && kept < 150)
+	// Output:
+	// true
+}
+
+func ExampleWeightedSample() {
+	type endpoint struct {
+		path string
+		hits int
+	}
+	endpoints := itu.Of(
+		endpoint{"/", 500},
+		endpoint{"/login", 100},
+		endpoint{"/health", 0},
+	)
+	rng := rand.New(rand.NewPCG(1, 2))
+	picked := itu.WeightedSample(endpoints, 5, func(e endpoint) float64 {
+		return float64(e.hits)
+	}, rng)
+	// Endpoints without hits are never picked.
+	fmt.Println(len(picked))
+	// Output:
+	// 2
+}
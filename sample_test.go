@@ -0,0 +1,124 @@
+package itu
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestSample_Deterministic(t *testing.T) {
+	a := Sample(Range(0, 1000), 5, newTestRand())
+	b := Sample(Range(0, 1000), 5, newTestRand())
+	if len(a) != 5 || !slices.Equal(a, b) {
+		t.Fatalf("Sample with equal seeds = %v and %v, want equal samples of 5", a, b)
+	}
+}
+
+func TestSample_Short(t *testing.T) {
+	got := Sample(Range(0, 3), 5, newTestRand())
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Sample(3 values, 5) = %v, want [0 1 2]", got)
+	}
+	if got := Sample(Range(0, 3), 0, nil); got != nil {
+		t.Fatalf("Sample(k=0) = %v, want nil", got)
+	}
+}
+
+func TestSample_Uniform(t *testing.T) {
+	rng := newTestRand()
+	const trials, n, k = 20_000, 10, 3
+	var counts [n]int
+	for range trials {
+		for _, v := range Sample(Range(0, n), k, rng) {
+			counts[v]++
+		}
+	}
+	want := trials * k / n
+	for v, c := range counts {
+		if c < want*9/10 || c > want*11/10 {
+			t.Errorf("Sample selected %d %d times, want about %d", v, c, want)
+		}
+	}
+}
+
+func TestSample_NegativePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Sample(k=-1) did not panic")
+		}
+	}()
+	Sample(Range(0, 3), -1, nil)
+}
+
+func TestBernoulli(t *testing.T) {
+	got := slices.Collect(Bernoulli(Range(0, 100_000), 0.1, newTestRand()))
+	if len(got) < 9_500 || len(got) > 10_500 {
+		t.Fatalf("Bernoulli(0.1) kept %d of 100000, want about 10000", len(got))
+	}
+	if !slices.IsSorted(got) {
+		t.Fatalf("Bernoulli reordered elements")
+	}
+	again := slices.Collect(Bernoulli(Range(0, 100_000), 0.1, newTestRand()))
+	if !slices.Equal(got, again) {
+		t.Fatalf("Bernoulli with equal seeds gave different results")
+	}
+}
+
+func TestBernoulli_Extremes(t *testing.T) {
+	if got := slices.Collect(Bernoulli(Range(0, 100), 0, nil)); len(got) != 0 {
+		t.Fatalf("Bernoulli(p=0) = %v, want empty", got)
+	}
+	if got := slices.Collect(Bernoulli(Range(0, 100), 1, nil)); len(got) != 100 {
+		t.Fatalf("Bernoulli(p=1) kept %d values, want 100", len(got))
+	}
+}
+
+func TestBernoulli_EarlyStop(t *testing.T) {
+	got := slices.Collect(Take(Bernoulli(RangeBy(0, 1, 0), 0.5, newTestRand()), 3))
+	if len(got) != 3 {
+		t.Fatalf("Take(Bernoulli(infinite), 3) = %v, want 3 values", got)
+	}
+}
+
+func TestBernoulli_InvalidPPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Bernoulli(p=1.5) did not panic")
+		}
+	}()
+	Bernoulli(Range(0, 3), 1.5, nil)
+}
+
+func TestWeightedSample(t *testing.T) {
+	rng := newTestRand()
+	weight := func(v int) float64 { return float64(v) } // 0 is never picked
+	var counts [4]int
+	for range 10_000 {
+		got := WeightedSample(Range(0, 4), 1, weight, rng)
+		if len(got) != 1 {
+			t.Fatalf("WeightedSample(k=1) = %v, want one value", got)
+		}
+		counts[got[0]]++
+	}
+	if counts[0] != 0 {
+		t.Fatalf("WeightedSample picked a zero-weight element %d times", counts[0])
+	}
+	// Weights 1:2:3 out of 6.
+	for v, want := range []int{0, 1667, 3333, 5000} {
+		if d := counts[v] - want; d < -200 || d > 200 {
+			t.Errorf("WeightedSample picked %d %d times, want about %d", v, counts[v], want)
+		}
+	}
+}
+
+func TestWeightedSample_FewerPositive(t *testing.T) {
+	got := WeightedSample(Of(-1, 0, 5, 7), 3, func(v int) float64 { return float64(v) }, nil)
+	slices.Sort(got)
+	if !slices.Equal(got, []int{5, 7}) {
+		t.Fatalf("WeightedSample = %v, want [5 7]", got)
+	}
+}
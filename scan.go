@@ -0,0 +1,91 @@
+package itu
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Scan returns a lazy iterator that folds seq from left to right like [Fold],
+// but yields the accumulator after every element instead of only the final
+// one. For each element x in seq it updates acc = fn(acc, x) and yields acc.
+//
+// The initial accumulator itself is not yielded, so the result has the same
+// length as seq. If seq is empty, Scan yields nothing.
+//
+// Note: if R is a reference type (map, slice, pointer, etc.), fn may mutate the
+// accumulator value, and every yielded value then refers to the same data.
+func Scan[T, R any](seq iter.Seq[T], acc R, fn func(R, T) R) iter.Seq[R] {
+	if fn == nil {
+		panic("itu: Scan: fn is nil")
+	}
+	return func(yield func(R) bool) {
+		acc := acc
+		for v := range seq {
+			acc = fn(acc, v)
+			if !yield(acc) {
+				return
+			}
+		}
+	}
+}
+
+// RunningSum returns a lazy iterator that yields the cumulative sum of seq:
+// the first element, the sum of the first two, and so on.
+//
+// Integer sums wrap around on overflow, as with the + operator.
+func RunningSum[T ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 |
+	~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64](seq iter.Seq[T]) iter.Seq[T] {
+	return Scan(seq, 0, func(acc, v T) T { return acc + v })
+}
+
+// RunningMin returns a lazy iterator that yields the smallest value of seq
+// seen so far, after every element.
+//
+// For floating-point types, a NaN is propagated: once seq yields a NaN, every
+// following value is NaN.
+func RunningMin[T cmp.Ordered](seq iter.Seq[T]) iter.Seq[T] {
+	return scan1(seq, func(a, b T) T { return min(a, b) })
+}
+
+// RunningMax returns a lazy iterator that yields the largest value of seq
+// seen so far, after every element.
+//
+// For floating-point types, a NaN is propagated: once seq yields a NaN, every
+// following value is NaN.
+func RunningMax[T cmp.Ordered](seq iter.Seq[T]) iter.Seq[T] {
+	return scan1(seq, func(a, b T) T { return max(a, b) })
+}
+
+// scan1 is like Scan, but uses the first element of seq as the initial
+// accumulator and yields it unchanged.
+func scan1[T any](seq iter.Seq[T], fn func(T, T) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var acc T
+		first := true
+		for v := range seq {
+			if first {
+				acc, first = v, false
+			} else {
+				acc = fn(acc, v)
+			}
+			if !yield(acc) {
+				return
+			}
+		}
+	}
+}
+
+// EMA returns a lazy iterator that yields the exponential moving average of
+// seq with smoothing factor alpha.
+//
+// The first value of seq is yielded unchanged and seeds the average; after
+// that each element x updates avg = alpha*x + (1-alpha)*avg. A larger alpha
+// gives more weight to recent values.
+//
+// EMA panics if alpha is not in the interval (0, 1].
+func EMA[T ~float32 | ~float64](seq iter.Seq[T], alpha T) iter.Seq[T] {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("itu: EMA: alpha must be in (0, 1]")
+	}
+	return scan1(seq, func(avg, v T) T { return alpha*v + (1-alpha)*avg })
+}
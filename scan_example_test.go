@@ -0,0 +1,45 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleScan() {
+	words := itu.Of("a", "b", "c")
+	for s := range itu.Scan(words, "", func(acc, w string) string { return acc + w }) {
+		fmt.Println(s)
+	}
+	// Output:
+	// a
+	// ab
+	// abc
+}
+
+func ExampleRunningSum() {
+	requests := itu.Of(3, 5, 2, 7)
+	fmt.Println(slices.Collect(itu.RunningSum(requests)))
+	// Output:
+	// [3 8 10 17]
+}
+
+func ExampleRunningMax() {
+	latencies := itu.Of(120, 80, 200, 150)
+	fmt.Println(slices.Collect(itu.RunningMax(latencies)))
+	// Output:
+	// [120 120 200 200]
+}
+
+func ExampleEMA() {
+	load := itu.Of(1.0, 3.0, 3.0, 3.0)
+	for v := range itu.EMA(load, 0.5) {
+		fmt.Printf("%.2f\n", v)
+	}
+	// Output:
+	// 1.00
+	// 2.00
+	// 2.50
+	// 2.75
+}
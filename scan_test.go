@@ -0,0 +1,96 @@
+package itu
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	got := slices.Collect(Scan(slices.Values([]int{1, 2, 3}), "", func(acc string, v int) string {
+		return acc + string(rune('a'+v-1))
+	}))
+	if want := []string{"a", "ab", "abc"}; !slices.Equal(got, want) {
+		t.Fatalf("Scan = %v, want %v", got, want)
+	}
+}
+
+func TestScan_Empty(t *testing.T) {
+	if got := slices.Collect(Scan(Empty[int](), 10, func(a, b int) int { return a + b })); len(got) != 0 {
+		t.Fatalf("Scan(empty) = %v, want empty", got)
+	}
+}
+
+func TestScan_Reusable(t *testing.T) {
+	seq := RunningSum(slices.Values([]int{1, 2, 3}))
+	for range seq {
+		break
+	}
+	if got := slices.Collect(seq); !slices.Equal(got, []int{1, 3, 6}) {
+		t.Fatalf("RunningSum second pass = %v, want [1 3 6]", got)
+	}
+}
+
+func TestScan_EarlyStop(t *testing.T) {
+	produced := 0
+	seq := func(yield func(int) bool) {
+		for i := range 10 {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	got := slices.Collect(Take(RunningMax(seq), 3))
+	if !slices.Equal(got, []int{0, 1, 2}) || produced != 3 {
+		t.Fatalf("Take(RunningMax, 3) = %v after %d values, want [0 1 2] after 3", got, produced)
+	}
+}
+
+func TestRunningMinMax(t *testing.T) {
+	in := []int{3, 1, 4, 1, 5, 0}
+	if got := slices.Collect(RunningMin(slices.Values(in))); !slices.Equal(got, []int{3, 1, 1, 1, 1, 0}) {
+		t.Fatalf("RunningMin = %v", got)
+	}
+	if got := slices.Collect(RunningMax(slices.Values(in))); !slices.Equal(got, []int{3, 3, 4, 4, 5, 5}) {
+		t.Fatalf("RunningMax = %v", got)
+	}
+}
+
+func TestRunningMin_NaN(t *testing.T) {
+	got := slices.Collect(RunningMin(slices.Values([]float64{2, math.NaN(), 1})))
+	if got[0] != 2 || !math.IsNaN(got[1]) || !math.IsNaN(got[2]) {
+		t.Fatalf("RunningMin with NaN = %v, want [2 NaN NaN]", got)
+	}
+}
+
+func TestRunningSum_Float(t *testing.T) {
+	got := slices.Collect(RunningSum(slices.Values([]float64{0.5, 1.5, -1})))
+	if want := []float64{0.5, 2, 1}; !slices.Equal(got, want) {
+		t.Fatalf("RunningSum = %v, want %v", got, want)
+	}
+}
+
+func TestEMA(t *testing.T) {
+	got := slices.Collect(EMA(slices.Values([]float64{10, 20, 20, 0}), 0.5))
+	if want := []float64{10, 15, 17.5, 8.75}; !slices.Equal(got, want) {
+		t.Fatalf("EMA = %v, want %v", got, want)
+	}
+	got = slices.Collect(EMA(slices.Values([]float64{1, 2, 3}), 1))
+	if want := []float64{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("EMA(alpha=1) = %v, want %v", got, want)
+	}
+}
+
+func TestEMA_InvalidAlphaPanics(t *testing.T) {
+	for _, alpha := range []float64{0, -0.5, 1.5, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("EMA(alpha=%v) did not panic", alpha)
+				}
+			}()
+			EMA(Empty[float64](), alpha)
+		}()
+	}
+}
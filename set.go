@@ -0,0 +1,141 @@
+package itu
+
+import (
+	"cmp"
+	"iter"
+)
+
+// The set operations below work on two inputs that are sorted in ascending
+// order. They walk both inputs in lockstep with [iter.Pull], so they never hold
+// more than one pending element per input in memory.
+//
+// Duplicates are matched pairwise (multiset semantics): if a value occurs m
+// times in seq1 and n times in seq2, Union yields it max(m, n) times, Intersect
+// min(m, n) times, Difference max(m-n, 0) times and SymmetricDifference |m-n|
+// times. Inputs without duplicates therefore behave like ordinary sets. When
+// both inputs hold an equal element, the element from seq1 is yielded.
+//
+// If an input is not sorted, the result is unspecified.
+
+// Union returns a lazy iterator over the sorted union of seq1 and seq2, using
+// [cmp.Compare] to order the elements.
+//
+// Union consumes the inputs only as the returned iterator is consumed.
+func Union[E cmp.Ordered](seq1, seq2 iter.Seq[E]) iter.Seq[E] {
+	return sortedSetOp(seq1, seq2, cmp.Compare[E], true, true, true)
+}
+
+// UnionFunc is like [Union] but orders the elements with cmpFn.
+//
+// UnionFunc panics if cmpFn is nil.
+func UnionFunc[E any](seq1, seq2 iter.Seq[E], cmpFn func(E, E) int) iter.Seq[E] {
+	if cmpFn == nil {
+		panic("itu: UnionFunc cmpFn is nil")
+	}
+	return sortedSetOp(seq1, seq2, cmpFn, true, true, true)
+}
+
+// Intersect returns a lazy iterator over the sorted elements present in both
+// seq1 and seq2, using [cmp.Compare] to order the elements.
+//
+// Intersect stops as soon as either input ends.
+func Intersect[E cmp.Ordered](seq1, seq2 iter.Seq[E]) iter.Seq[E] {
+	return sortedSetOp(seq1, seq2, cmp.Compare[E], false, false, true)
+}
+
+// IntersectFunc is like [Intersect] but orders the elements with cmpFn.
+//
+// IntersectFunc panics if cmpFn is nil.
+func IntersectFunc[E any](seq1, seq2 iter.Seq[E], cmpFn func(E, E) int) iter.Seq[E] {
+	if cmpFn == nil {
+		panic("itu: IntersectFunc cmpFn is nil")
+	}
+	return sortedSetOp(seq1, seq2, cmpFn, false, false, true)
+}
+
+// Difference returns a lazy iterator over the sorted elements of seq1 that are
+// not present in seq2, using [cmp.Compare] to order the elements.
+//
+// Difference stops as soon as seq1 ends.
+func Difference[E cmp.Ordered](seq1, seq2 iter.Seq[E]) iter.Seq[E] {
+	return sortedSetOp(seq1, seq2, cmp.Compare[E], true, false, false)
+}
+
+// DifferenceFunc is like [Difference] but orders the elements with cmpFn.
+//
+// DifferenceFunc panics if cmpFn is nil.
+func DifferenceFunc[E any](seq1, seq2 iter.Seq[E], cmpFn func(E, E) int) iter.Seq[E] {
+	if cmpFn == nil {
+		panic("itu: DifferenceFunc cmpFn is nil")
+	}
+	return sortedSetOp(seq1, seq2, cmpFn, true, false, false)
+}
+
+// SymmetricDifference returns a lazy iterator over the sorted elements present
+// in exactly one of seq1 and seq2, using [cmp.Compare] to order the elements.
+func SymmetricDifference[E cmp.Ordered](seq1, seq2 iter.Seq[E]) iter.Seq[E] {
+	return sortedSetOp(seq1, seq2, cmp.Compare[E], true, true, false)
+}
+
+// SymmetricDifferenceFunc is like [SymmetricDifference] but orders the
+// elements with cmpFn.
+//
+// SymmetricDifferenceFunc panics if cmpFn is nil.
+func SymmetricDifferenceFunc[E any](seq1, seq2 iter.Seq[E], cmpFn func(E, E) int) iter.Seq[E] {
+	if cmpFn == nil {
+		panic("itu: SymmetricDifferenceFunc cmpFn is nil")
+	}
+	return sortedSetOp(seq1, seq2, cmpFn, true, true, false)
+}
+
+// sortedSetOp merges two sorted inputs, yielding elements found only in seq1
+// (if onlyFirst), only in seq2 (if onlySecond) and in both (if both).
+func sortedSetOp[E any](seq1, seq2 iter.Seq[E], cmpFn func(E, E) int, onlyFirst, onlySecond, both bool) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		next1, stop1 := iter.Pull(seq1)
+		defer stop1()
+
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+
+		v1, ok1 := next1()
+		if !ok1 && !onlySecond {
+			return
+		}
+		v2, ok2 := next2()
+
+		for ok1 && ok2 {
+			switch c := cmpFn(v1, v2); {
+			case c < 0:
+				if onlyFirst && !yield(v1) {
+					return
+				}
+				v1, ok1 = next1()
+			case c > 0:
+				if onlySecond && !yield(v2) {
+					return
+				}
+				v2, ok2 = next2()
+			default:
+				if both && !yield(v1) {
+					return
+				}
+				v1, ok1 = next1()
+				v2, ok2 = next2()
+			}
+		}
+
+		for ok1 && onlyFirst {
+			if !yield(v1) {
+				return
+			}
+			v1, ok1 = next1()
+		}
+		for ok2 && onlySecond {
+			if !yield(v2) {
+				return
+			}
+			v2, ok2 = next2()
+		}
+	}
+}
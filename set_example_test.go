@@ -0,0 +1,79 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleUnion() {
+	a := itu.Of(1, 3, 5)
+	b := itu.Of(2, 3, 6)
+	fmt.Println(slices.Collect(itu.Union(a, b)))
+	// Output:
+	// [1 2 3 5 6]
+}
+
+func ExampleUnionFunc() {
+	byLower := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+	a := itu.Of("Ant", "cat")
+	b := itu.Of("ant", "Bee")
+	fmt.Println(slices.Collect(itu.UnionFunc(a, b, byLower)))
+	// Output:
+	// [Ant Bee cat]
+}
+
+func ExampleIntersect() {
+	exportA := itu.Of(100, 101, 105, 110)
+	exportB := itu.Of(101, 102, 110)
+	fmt.Println(slices.Collect(itu.Intersect(exportA, exportB)))
+	// Output:
+	// [101 110]
+}
+
+func ExampleIntersectFunc() {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	a := itu.Of("a", "bb", "cccc")
+	b := itu.Of("xx", "yyyy")
+	fmt.Println(slices.Collect(itu.IntersectFunc(a, b, byLen)))
+	// Output:
+	// [bb cccc]
+}
+
+func ExampleDifference() {
+	before := itu.Of(1, 2, 3, 4)
+	after := itu.Of(2, 4, 5)
+	fmt.Println("removed:", slices.Collect(itu.Difference(before, after)))
+	fmt.Println("added:", slices.Collect(itu.Difference(after, before)))
+	// Output:
+	// removed: [1 3]
+	// added: [5]
+}
+
+func ExampleDifferenceFunc() {
+	desc := func(a, b int) int { return b - a }
+	a := itu.Of(9, 7, 5, 3)
+	b := itu.Of(7, 3)
+	fmt.Println(slices.Collect(itu.DifferenceFunc(a, b, desc)))
+	// Output:
+	// [9 5]
+}
+
+func ExampleSymmetricDifference() {
+	a := itu.Of("a", "b", "c")
+	b := itu.Of("b", "c", "d")
+	fmt.Println(slices.Collect(itu.SymmetricDifference(a, b)))
+	// Output:
+	// [a d]
+}
+
+func ExampleSymmetricDifferenceFunc() {
+	desc := func(a, b int) int { return b - a }
+	a := itu.Of(5, 3, 1)
+	b := itu.Of(4, 3)
+	fmt.Println(slices.Collect(itu.SymmetricDifferenceFunc(a, b, desc)))
+	// Output:
+	// [5 4 1]
+}
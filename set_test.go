@@ -0,0 +1,154 @@
+package itu
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSetOps_Sets(t *testing.T) {
+	a := []int{1, 3, 5, 7, 9}
+	b := []int{3, 4, 5, 6, 10}
+
+	cases := []struct {
+		name string
+		op   func(iter.Seq[int], iter.Seq[int]) iter.Seq[int]
+		want []int
+	}{
+		{"Union", Union[int], []int{1, 3, 4, 5, 6, 7, 9, 10}},
+		{"Intersect", Intersect[int], []int{3, 5}},
+		{"Difference", Difference[int], []int{1, 7, 9}},
+		{"SymmetricDifference", SymmetricDifference[int], []int{1, 4, 6, 7, 9, 10}},
+	}
+	for _, tc := range cases {
+		got := slices.Collect(tc.op(slices.Values(a), slices.Values(b)))
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("%s(%v, %v) = %v, want %v", tc.name, a, b, got, tc.want)
+		}
+	}
+}
+
+func TestSetOps_Multisets(t *testing.T) {
+	a := []int{1, 1, 1, 2, 3}
+	b := []int{1, 2, 2, 4}
+
+	cases := []struct {
+		name string
+		op   func(iter.Seq[int], iter.Seq[int]) iter.Seq[int]
+		want []int
+	}{
+		{"Union", Union[int], []int{1, 1, 1, 2, 2, 3, 4}},
+		{"Intersect", Intersect[int], []int{1, 2}},
+		{"Difference", Difference[int], []int{1, 1, 3}},
+		{"SymmetricDifference", SymmetricDifference[int], []int{1, 1, 2, 3, 4}},
+	}
+	for _, tc := range cases {
+		got := slices.Collect(tc.op(slices.Values(a), slices.Values(b)))
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("%s(%v, %v) = %v, want %v", tc.name, a, b, got, tc.want)
+		}
+	}
+}
+
+func TestSetOps_EmptyInputs(t *testing.T) {
+	vals := Of(1, 2)
+	empty := Empty[int]()
+
+	if got := slices.Collect(Union(empty, vals)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Union(empty, [1 2]) = %v, want [1 2]", got)
+	}
+	if got := slices.Collect(Union(vals, empty)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Union([1 2], empty) = %v, want [1 2]", got)
+	}
+	if got := slices.Collect(Intersect(vals, empty)); len(got) != 0 {
+		t.Fatalf("Intersect([1 2], empty) = %v, want empty", got)
+	}
+	if got := slices.Collect(Difference(empty, vals)); len(got) != 0 {
+		t.Fatalf("Difference(empty, [1 2]) = %v, want empty", got)
+	}
+	if got := slices.Collect(Difference(vals, empty)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Difference([1 2], empty) = %v, want [1 2]", got)
+	}
+	if got := slices.Collect(SymmetricDifference(empty, vals)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("SymmetricDifference(empty, [1 2]) = %v, want [1 2]", got)
+	}
+}
+
+func TestIntersect_InfiniteInputs(t *testing.T) {
+	multiplesOf2 := RangeFromBy(0, 2)
+	multiplesOf3 := RangeFromBy(0, 3)
+	got := slices.Collect(Take(Intersect(multiplesOf2, multiplesOf3), 4))
+	want := []int{0, 6, 12, 18}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Intersect(2n, 3n) first 4 = %v, want %v", got, want)
+	}
+}
+
+func TestDifference_DoesNotDrainSecondInput(t *testing.T) {
+	// seq2 is infinite; Difference must stop once seq1 ends.
+	got := slices.Collect(Difference(Of(1, 2, 3, 4), RangeFromBy(2, 2)))
+	want := []int{1, 3}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Difference([1 2 3 4], evens from 2) = %v, want %v", got, want)
+	}
+}
+
+func TestUnion_StopsWhenConsumerStops(t *testing.T) {
+	produced := 0
+	src := func(values ...int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			for _, v := range values {
+				produced++
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+	got := slices.Collect(Take(Union(src(1, 3, 5), src(2, 4, 6)), 2))
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Union first 2 = %v, want [1 2]", got)
+	}
+	if produced != 3 {
+		t.Fatalf("Union consumed %d values, want 3", produced)
+	}
+}
+
+func TestSetOpsFunc_CustomOrder(t *testing.T) {
+	fold := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+	a := []string{"Apple", "banana", "Cherry"}
+	b := []string{"apple", "Cherry", "date"}
+
+	if got := slices.Collect(UnionFunc(slices.Values(a), slices.Values(b), fold)); !slices.Equal(got, []string{"Apple", "banana", "Cherry", "date"}) {
+		t.Fatalf("UnionFunc = %v", got)
+	}
+	if got := slices.Collect(IntersectFunc(slices.Values(a), slices.Values(b), fold)); !slices.Equal(got, []string{"Apple", "Cherry"}) {
+		t.Fatalf("IntersectFunc = %v", got)
+	}
+	if got := slices.Collect(DifferenceFunc(slices.Values(a), slices.Values(b), fold)); !slices.Equal(got, []string{"banana"}) {
+		t.Fatalf("DifferenceFunc = %v", got)
+	}
+	if got := slices.Collect(SymmetricDifferenceFunc(slices.Values(a), slices.Values(b), fold)); !slices.Equal(got, []string{"banana", "date"}) {
+		t.Fatalf("SymmetricDifferenceFunc = %v", got)
+	}
+}
+
+func TestSetOpsFunc_PanicOnNilCmp(t *testing.T) {
+	ops := map[string]func(){
+		"UnionFunc":               func() { UnionFunc[int](Of(1), Of(1), nil) },
+		"IntersectFunc":           func() { IntersectFunc[int](Of(1), Of(1), nil) },
+		"DifferenceFunc":          func() { DifferenceFunc[int](Of(1), Of(1), nil) },
+		"SymmetricDifferenceFunc": func() { SymmetricDifferenceFunc[int](Of(1), Of(1), nil) },
+	}
+	for name, op := range ops {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Fatalf("%s(nil cmpFn) did not panic, want panic", name)
+				}
+			}()
+			op()
+		}()
+	}
+}
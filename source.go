@@ -0,0 +1,65 @@
+package itu
+
+import (
+	"iter"
+	"sync"
+)
+
+// goSource iterates a sequence on its own goroutine and delivers the values
+// over a channel, so that consumers can select on them together with timers,
+// contexts or other sources.
+type goSource[T any] struct {
+	ch       chan T
+	done     chan struct{}
+	finished chan struct{}
+	stopOnce sync.Once
+
+	// Set before ch is closed; safe to read once a receive from ch reports
+	// that it is closed.
+	panicked bool
+	panicVal any
+}
+
+// startSource starts iterating seq on a new goroutine. Values are sent on a
+// channel with the given buffer size, which is closed when seq ends, panics, or
+// stop is called.
+func startSource[T any](seq iter.Seq[T], buf int) *goSource[T] {
+	s := &goSource[T]{
+		ch:       make(chan T, buf),
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+	go func() {
+		defer close(s.finished)
+		defer close(s.ch)
+		defer func() {
+			if p := recover(); p != nil {
+				s.panicked = true
+				s.panicVal = p
+			}
+		}()
+		for v := range seq {
+			select {
+			case s.ch <- v:
+			case <-s.done:
+				return
+			}
+		}
+	}()
+	return s
+}
+
+// stop asks the source goroutine to stop and waits for it to return. It is
+// safe to call stop more than once.
+func (s *goSource[T]) stop() {
+	s.stopOnce.Do(func() { close(s.done) })
+	<-s.finished
+}
+
+// rethrow re-raises a panic from seq on the calling goroutine. It must only be
+// called after a receive from s.ch has reported that the channel is closed.
+func (s *goSource[T]) rethrow() {
+	if s.panicked {
+		panic(s.panicVal)
+	}
+}
@@ -0,0 +1,83 @@
+package itu
+
+import (
+	"iter"
+	"math"
+)
+
+// Sum returns the sum of all values in seq, or 0 if seq is empty.
+// Sum consumes seq eagerly.
+//
+// Integer sums wrap around on overflow, as with the + operator.
+func Sum[T Number](seq iter.Seq[T]) T {
+	var sum T
+	for v := range seq {
+		sum += v
+	}
+	return sum
+}
+
+// Product returns the product of all values in seq, or 1 if seq is empty.
+// Product consumes seq eagerly.
+//
+// Integer products wrap around on overflow, as with the * operator.
+func Product[T Number](seq iter.Seq[T]) T {
+	var prod T = 1
+	for v := range seq {
+		prod *= v
+	}
+	return prod
+}
+
+// Mean returns the arithmetic mean of the values in seq as a float64.
+// If seq is empty, it returns (0, false).
+//
+// Mean consumes seq eagerly in a single pass and in constant memory. The mean
+// is updated incrementally, so it does not overflow even when the sum of the
+// values would.
+func Mean[T Number](seq iter.Seq[T]) (float64, bool) {
+	w := welford(seq)
+	return w.mean, w.n > 0
+}
+
+// Variance returns the population variance of the values in seq, that is the
+// mean of the squared deviations from their mean. If seq is empty, it returns
+// (0, false).
+//
+// To get the sample variance of n values instead, multiply the result by
+// n/(n-1).
+//
+// Variance consumes seq eagerly in a single pass and in constant memory, using
+// Welford's algorithm, which is numerically stable.
+func Variance[T Number](seq iter.Seq[T]) (float64, bool) {
+	w := welford(seq)
+	if w.n == 0 {
+		return 0, false
+	}
+	return w.m2 / float64(w.n), true
+}
+
+// StdDev returns the population standard deviation of the values in seq, the
+// square root of their [Variance]. If seq is empty, it returns (0, false).
+func StdDev[T Number](seq iter.Seq[T]) (float64, bool) {
+	v, ok := Variance(seq)
+	return math.Sqrt(v), ok
+}
+
+type welfordState struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+func welford[T Number](seq iter.Seq[T]) welfordState {
+	var w welfordState
+	for v := range seq {
+		x := float64(v)
+		w.n++
+		d := x - w.mean
+		w.mean += d / float64(w.n)
+		w.m2 += d * (x - w.mean)
+	}
+	return w
+}
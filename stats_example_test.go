@@ -0,0 +1,35 @@
+package itu_test
+
+import (
+	"fmt"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleSum() {
+	fmt.Println(itu.Sum(itu.Of(1, 2, 3, 4)))
+	// Output:
+	// 10
+}
+
+func ExampleProduct() {
+	fmt.Println(itu.Product(itu.Range(1, 6)))
+	// Output:
+	// 120
+}
+
+func ExampleMean() {
+	fmt.Println(itu.Mean(itu.Of(1, 2, 3, 4)))
+	fmt.Println(itu.Mean(itu.Empty[int]()))
+	// Output:
+	// 2.5 true
+	// 0 false
+}
+
+func ExampleStdDev() {
+	sd, _ := itu.StdDev(itu.Of(2, 4, 4, 4, 5, 5, 7, 9))
+	v, _ := itu.Variance(itu.Of(2, 4, 4, 4, 5, 5, 7, 9))
+	fmt.Println(sd, v)
+	// Output:
+	// 2 4
+}
@@ -0,0 +1,62 @@
+package itu
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSumProduct(t *testing.T) {
+	if got := Sum(slices.Values([]int{1, 2, 3, 4})); got != 10 {
+		t.Fatalf("Sum = %d, want 10", got)
+	}
+	if got := Product(slices.Values([]int{1, 2, 3, 4})); got != 24 {
+		t.Fatalf("Product = %d, want 24", got)
+	}
+	if got := Sum(Empty[float64]()); got != 0 {
+		t.Fatalf("Sum(empty) = %v, want 0", got)
+	}
+	if got := Product(Empty[uint8]()); got != 1 {
+		t.Fatalf("Product(empty) = %d, want 1", got)
+	}
+}
+
+func TestMean(t *testing.T) {
+	if got, ok := Mean(slices.Values([]int{1, 2, 3, 4})); !ok || got != 2.5 {
+		t.Fatalf("Mean = (%v, %v), want (2.5, true)", got, ok)
+	}
+	if got, ok := Mean(Empty[int]()); ok || got != 0 {
+		t.Fatalf("Mean(empty) = (%v, %v), want (0, false)", got, ok)
+	}
+}
+
+func TestMean_NoOverflow(t *testing.T) {
+	got, _ := Mean(slices.Values([]int8{100, 100, 100}))
+	if got != 100 {
+		t.Fatalf("Mean(int8 100s) = %v, want 100", got)
+	}
+}
+
+func TestVarianceStdDev(t *testing.T) {
+	seq := slices.Values([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	if got, ok := Variance(seq); !ok || got != 4 {
+		t.Fatalf("Variance = (%v, %v), want (4, true)", got, ok)
+	}
+	if got, ok := StdDev(seq); !ok || got != 2 {
+		t.Fatalf("StdDev = (%v, %v), want (2, true)", got, ok)
+	}
+	if got, ok := Variance(Of(42)); !ok || got != 0 {
+		t.Fatalf("Variance(single) = (%v, %v), want (0, true)", got, ok)
+	}
+	if _, ok := StdDev(Empty[int]()); ok {
+		t.Fatalf("StdDev(empty) ok = true, want false")
+	}
+}
+
+func TestVariance_Stable(t *testing.T) {
+	// A large offset makes the naive sum-of-squares formula lose all precision.
+	in := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	if got, _ := Variance(slices.Values(in)); math.Abs(got-22.5) > 1e-6 {
+		t.Fatalf("Variance(offset) = %v, want 22.5", got)
+	}
+}
@@ -0,0 +1,302 @@
+package itu
+
+import (
+	"iter"
+	"sync"
+)
+
+// Tee returns n sequences that each yield the values of seq once per pass,
+// sharing a single pass over it and buffering at most limit values. This
+// makes it possible to consume a sequence that can only be iterated once, such
+// as one reading from a network connection, in several ways.
+//
+// The returned sequences are meant to be consumed on the same goroutine, one
+// after the other or interleaved, for example with [Zip]. seq is advanced by
+// whichever of them is furthest ahead, and each value is buffered until every
+// sequence that has not yet finished its iteration has seen it. Interleaved
+// consumers need almost no buffer, while consuming one sequence completely
+// before the next buffers all of seq. A single goroutine cannot wait for a
+// slower consumer, so if advancing seq would make the buffer hold more than
+// limit values, Tee panics.
+//
+// Unlike [Unzip], a returned sequence that is iterated again while the others
+// are still in the pass yields nothing, since seq may not be iterable twice.
+// Once all n sequences have finished, the pass ends and iterating any of them
+// starts a new one. Use [Broadcast] for consumers on different goroutines.
+//
+// Even interleaved consumers need room for one value, the one the leading
+// sequence has just taken from seq, so limit must be at least 1.
+//
+// Tee panics if n is negative or limit is not positive.
+func Tee[T any](seq iter.Seq[T], n, limit int) []iter.Seq[T] {
+	if n < 0 {
+		panic("itu: Tee: n must be non-negative")
+	}
+	if limit < 1 {
+		panic("itu: Tee: limit must be positive")
+	}
+	s := &teeState[T]{seq: seq, limit: limit, views: make([]teeView, n)}
+	out := make([]iter.Seq[T], n)
+	for i := range out {
+		out[i] = s.view(i)
+	}
+	return out
+}
+
+// States of a view in the current pass.
+const (
+	teeIdle = iota
+	teeActive
+	teeDone
+)
+
+type teeView struct {
+	state int
+	pos   int // absolute index of the next value to yield
+}
+
+type teeState[T any] struct {
+	seq   iter.Seq[T]
+	limit int
+	next  func() (T, bool)
+	stop  func()
+	ended bool
+
+	// buf holds the values from index base on that some view has yet to see.
+	buf   []T
+	base  int
+	views []teeView
+}
+
+func (s *teeState[T]) view(i int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		v := &s.views[i]
+		if v.state != teeIdle {
+			return
+		}
+		v.state = teeActive
+		defer s.finish(i)
+
+		for {
+			if v.pos-s.base < len(s.buf) {
+				x := s.buf[v.pos-s.base]
+				v.pos++
+				s.trim()
+				if !yield(x) {
+					return
+				}
+				continue
+			}
+
+			if s.ended {
+				return
+			}
+			if s.next == nil {
+				s.next, s.stop = iter.Pull(s.seq)
+			}
+			x, ok := s.next()
+			if !ok {
+				s.ended = true
+				return
+			}
+			// This view is at the head of the pass, so the buffer holds only
+			// values that other views still need, and so will x.
+			if len(s.buf) >= s.limit && s.waiting(i) {
+				panic("itu: Tee: buffer limit exceeded")
+			}
+			s.buf = append(s.buf, x)
+			v.pos++
+			s.trim()
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// waiting reports whether a view other than i has yet to finish the pass.
+func (s *teeState[T]) waiting(i int) bool {
+	for j, v := range s.views {
+		if j != i && v.state != teeDone {
+			return true
+		}
+	}
+	return false
+}
+
+// trim drops the buffered values that every unfinished view has seen.
+func (s *teeState[T]) trim() {
+	lowest := s.base + len(s.buf)
+	for _, v := range s.views {
+		if v.state != teeDone {
+			lowest = min(lowest, v.pos)
+		}
+	}
+	k := lowest - s.base
+	if k == 0 {
+		return
+	}
+	clear(s.buf[:k])
+	s.buf = s.buf[k:]
+	s.base = lowest
+}
+
+// finish marks view i as done, and ends the pass once all views are.
+func (s *teeState[T]) finish(i int) {
+	s.views[i].state = teeDone
+	for _, v := range s.views {
+		if v.state != teeDone {
+			s.trim()
+			return
+		}
+	}
+	if s.stop != nil {
+		s.stop()
+	}
+	s.next, s.stop, s.ended = nil, nil, false
+	s.buf, s.base = nil, 0
+	clear(s.views)
+}
+
+// Broadcast returns n sequences that each yield all the values of seq, for
+// consumers running on different goroutines.
+//
+// seq is iterated once, on its own goroutine, which starts when the first of
+// the returned sequences is iterated. Each value is delivered to every
+// consumer through a channel with the given buffer size, so a consumer can
+// fall behind the fastest one by at most buf values; beyond that, seq is not
+// advanced until the slowest consumer catches up. Every returned sequence
+// must therefore be iterated, or the others eventually block.
+//
+// A consumer that stops early is detached and no longer receives values. Once
+// all consumers have detached, Broadcast stops pulling from seq, and the last
+// one to detach waits for the goroutine to return. If seq panics, the panic is
+// re-raised on the goroutine of every consumer still attached.
+//
+// Each returned sequence can be iterated only once; iterating it again yields
+// nothing.
+//
+// Broadcast panics if n or buf is negative.
+func Broadcast[T any](seq iter.Seq[T], n, buf int) []iter.Seq[T] {
+	if n < 0 {
+		panic("itu: Broadcast: n must be non-negative")
+	}
+	if buf < 0 {
+		panic("itu: Broadcast: buf must be non-negative")
+	}
+
+	b := &broadcast[T]{
+		seq:      seq,
+		subs:     make([]broadcastSub[T], n),
+		finished: make(chan struct{}),
+		attached: n,
+	}
+	for i := range b.subs {
+		b.subs[i] = broadcastSub[T]{ch: make(chan T, buf), done: make(chan struct{})}
+	}
+	out := make([]iter.Seq[T], n)
+	for i := range out {
+		out[i] = b.view(i)
+	}
+	return out
+}
+
+type broadcast[T any] struct {
+	seq       iter.Seq[T]
+	subs      []broadcastSub[T]
+	startOnce sync.Once
+	finished  chan struct{}
+
+	mu       sync.Mutex
+	attached int
+
+	// Set before the channels are closed; safe to read once a receive reports
+	// that a channel is closed.
+	panicked bool
+	panicVal any
+}
+
+type broadcastSub[T any] struct {
+	ch   chan T
+	done chan struct{}
+	used sync.Once
+}
+
+func (b *broadcast[T]) view(i int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sub := &b.subs[i]
+		first := false
+		sub.used.Do(func() { first = true })
+		if !first {
+			return
+		}
+		b.startOnce.Do(func() { go b.run() })
+		defer b.detach(sub)
+
+		for v := range sub.ch {
+			if !yield(v) {
+				return
+			}
+		}
+		if b.panicked {
+			panic(b.panicVal)
+		}
+	}
+}
+
+func (b *broadcast[T]) run() {
+	defer close(b.finished)
+	defer func() {
+		for i := range b.subs {
+			close(b.subs[i].ch)
+		}
+	}()
+	defer func() {
+		if p := recover(); p != nil {
+			b.panicked = true
+			b.panicVal = p
+		}
+	}()
+
+	live := make([]bool, len(b.subs))
+	for i := range live {
+		live[i] = true
+	}
+	remaining := len(live)
+	for v := range b.seq {
+		for i := range b.subs {
+			if !live[i] {
+				continue
+			}
+			select {
+			case <-b.subs[i].done:
+				live[i] = false
+				remaining--
+				continue
+			default:
+			}
+			select {
+			case b.subs[i].ch <- v:
+			case <-b.subs[i].done:
+				live[i] = false
+				remaining--
+			}
+		}
+		if remaining == 0 {
+			return
+		}
+	}
+}
+
+// detach unsubscribes sub. The last consumer to detach waits for the source
+// goroutine to return.
+func (b *broadcast[T]) detach(sub *broadcastSub[T]) {
+	close(sub.done)
+	b.mu.Lock()
+	b.attached--
+	last := b.attached == 0
+	b.mu.Unlock()
+	if last {
+		<-b.finished
+	}
+}
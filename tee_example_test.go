@@ -0,0 +1,40 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleTee() {
+	// Lines can only be read once from the reader.
+	lines, errf := itu.ValuesErr(itu.Lines(strings.NewReader("a\nb\nc\n")))
+	views := itu.Tee(lines, 2, 16)
+	fmt.Println(itu.Count(views[0]))
+	fmt.Println(slices.Collect(views[1]), errf())
+	// Output:
+	// 3
+	// [a b c] <nil>
+}
+
+func ExampleBroadcast() {
+	views := itu.Broadcast(itu.Range(1, 101), 2, 16)
+	var sum, count int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		sum = itu.Sum(views[0])
+	}()
+	go func() {
+		defer wg.Done()
+		count = itu.Count(views[1])
+	}()
+	wg.Wait()
+	fmt.Println(sum, count)
+	// Output:
+	// 5050 100
+}
@@ -0,0 +1,298 @@
+package itu
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// onceSeq yields 0..n-1 the first time it is iterated and nothing afterwards,
+// like a network reader.
+func onceSeq(n int, produced *int) iter.Seq[int] {
+	i := 0
+	return func(yield func(int) bool) {
+		for ; i < n; i++ {
+			*produced++
+			if !yield(i) {
+				i++
+				return
+			}
+		}
+	}
+}
+
+func TestTee_Sequential(t *testing.T) {
+	produced := 0
+	views := Tee(onceSeq(4, &produced), 2, 4)
+	count := Count(views[0])
+	got := slices.Collect(views[1])
+	if count != 4 || !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("Tee: count = %d, values = %v, want 4, [0 1 2 3]", count, got)
+	}
+	if produced != 4 {
+		t.Fatalf("Tee pulled %d values from the source, want 4", produced)
+	}
+}
+
+func TestTee_Interleaved(t *testing.T) {
+	produced := 0
+	views := Tee(onceSeq(3, &produced), 2, 1)
+	got := collect2(Zip(views[0], views[1]))
+	want := []pair[int, int]{{0, 0}, {1, 1}, {2, 2}}
+	if !reflect.DeepEqual(got, want) || produced != 3 {
+		t.Fatalf("Zip(Tee) = %v after %d values, want %v after 3", got, produced, want)
+	}
+}
+
+func TestTee_LockstepWithPull(t *testing.T) {
+	views := Tee(Range(0, 100), 2, 1)
+	s := views[0]
+	next, stop := iter.Pull(views[1])
+	defer stop()
+	i := 0
+	for v := range s {
+		if w, _ := next(); w != v {
+			t.Fatalf("views disagree: %d != %d", v, w)
+		}
+		i++
+	}
+	if i != 100 {
+		t.Fatalf("Tee view yielded %d values, want 100", i)
+	}
+}
+
+func TestTee_EarlyStop(t *testing.T) {
+	produced := 0
+	views := Tee(onceSeq(10, &produced), 3, 10)
+	if got := slices.Collect(Take(views[0], 2)); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("Take(view 0, 2) = %v, want [0 1]", got)
+	}
+	if got := slices.Collect(Take(views[1], 4)); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("Take(view 1, 4) = %v, want [0 1 2 3]", got)
+	}
+	if got := slices.Collect(views[2]); len(got) != 10 {
+		t.Fatalf("view 2 = %v, want 10 values", got)
+	}
+	if produced != 10 {
+		t.Fatalf("Tee pulled %d values, want 10", produced)
+	}
+}
+
+func TestTee_RepeatDuringPass(t *testing.T) {
+	views := Tee(Range(0, 3), 2, 3)
+	Count(views[0])
+	if got := slices.Collect(views[0]); len(got) != 0 {
+		t.Fatalf("view iterated again during the pass = %v, want empty", got)
+	}
+	if got := slices.Collect(views[1]); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("second view = %v, want [0 1 2]", got)
+	}
+	// Both views finished, so a new pass over the re-iterable source starts.
+	if got := slices.Collect(views[0]); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("view in new pass = %v, want [0 1 2]", got)
+	}
+}
+
+func TestTee_StopsSource(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	views := Tee(seq, 2, 3)
+	Count(Take(views[0], 3))
+	if stopped {
+		t.Fatalf("source stopped while a view was still pending")
+	}
+	Count(Take(views[1], 1))
+	if !stopped {
+		t.Fatalf("source not stopped after all views finished")
+	}
+}
+
+func TestTee_Zero(t *testing.T) {
+	if got := Tee(Range(0, 3), 0, 1); len(got) != 0 {
+		t.Fatalf("Tee(n=0) returned %d sequences, want 0", len(got))
+	}
+}
+
+func TestTee_BufferLimit(t *testing.T) {
+	views := Tee(Range(0, 1_000_000), 2, 3)
+	defer func() {
+		if r := recover(); r != "itu: Tee: buffer limit exceeded" {
+			t.Fatalf("recovered %v, want buffer limit panic", r)
+		}
+	}()
+	Count(views[0])
+	t.Fatalf("Tee buffered more than its limit")
+}
+
+func TestTee_BufferLimitExact(t *testing.T) {
+	views := Tee(Range(0, 3), 2, 3)
+	if got := Count(views[0]); got != 3 {
+		t.Fatalf("Count(view 0) = %d, want 3", got)
+	}
+	if got := slices.Collect(views[1]); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("view 1 = %v, want [0 1 2]", got)
+	}
+}
+
+func TestTee_InterleavedWithLimitOne(t *testing.T) {
+	views := Tee(Range(0, 5), 2, 1)
+	got := collect2(Zip(views[0], views[1]))
+	want := []pair[int, int]{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {4, 4}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Zip(Tee(limit 1)) = %v, want %v", got, want)
+	}
+}
+
+func TestTee_NonPositiveLimitPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "itu: Tee: limit must be positive" {
+			t.Fatalf("recovered %v, want limit panic", r)
+		}
+	}()
+	Tee(Range(0, 5), 2, 0)
+}
+
+func TestTee_FinishedViewsNeedNoBuffer(t *testing.T) {
+	views := Tee(Range(0, 100), 2, 1)
+	for range views[1] {
+		break
+	}
+	// views[1] has finished, so views[0] can read on without buffering.
+	if got := Count(views[0]); got != 100 {
+		t.Fatalf("Count(view 0) = %d, want 100", got)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	produced := 0
+	views := Broadcast(onceSeq(1000, &produced), 3, 4)
+	results := make([][]int, len(views))
+	var wg sync.WaitGroup
+	for i, view := range views {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = slices.Collect(view)
+		}()
+	}
+	wg.Wait()
+	want := slices.Collect(Range(0, 1000))
+	for i, got := range results {
+		if !slices.Equal(got, want) {
+			t.Fatalf("Broadcast consumer %d got %d values, want 1000 in order", i, len(got))
+		}
+	}
+	if produced != 1000 {
+		t.Fatalf("Broadcast pulled %d values, want 1000", produced)
+	}
+}
+
+func TestBroadcast_Backpressure(t *testing.T) {
+	var mu sync.Mutex
+	produced := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			mu.Lock()
+			produced++
+			mu.Unlock()
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	views := Broadcast(seq, 2, 2)
+	// The first consumer reads 10 values while the second has not started.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range Take(views[0], 10) {
+		}
+	}()
+
+	next, stop := iter.Pull(views[1])
+	// Give the source time to run ahead as far as it can.
+	<-time.After(20 * time.Millisecond)
+	mu.Lock()
+	got := produced
+	mu.Unlock()
+	// The second consumer's buffer holds 2 values, plus one in flight.
+	if got > 3 {
+		t.Fatalf("Broadcast produced %d values before the slow consumer read any, want at most 3", got)
+	}
+	for range 12 {
+		next()
+	}
+	stop()
+	<-done
+}
+
+func TestBroadcast_EarlyStop(t *testing.T) {
+	stopped := make(chan struct{})
+	seq := func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	views := Broadcast(seq, 2, 0)
+	var wg sync.WaitGroup
+	for i, view := range views {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Count(Take(view, 5*(i+1)))
+		}()
+	}
+	wg.Wait()
+	select {
+	case <-stopped:
+	default:
+		t.Fatalf("source still running after all consumers stopped")
+	}
+}
+
+func TestBroadcast_Panic(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		yield(1)
+		panic(errBoom)
+	}
+	views := Broadcast(seq, 2, 1)
+	var wg sync.WaitGroup
+	recovered := make([]any, len(views))
+	for i, view := range views {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { recovered[i] = recover() }()
+			Count(view)
+		}()
+	}
+	wg.Wait()
+	for i, r := range recovered {
+		if r != errBoom {
+			t.Fatalf("consumer %d recovered %v, want %v", i, r, errBoom)
+		}
+	}
+}
+
+func TestBroadcast_SingleUse(t *testing.T) {
+	views := Broadcast(Range(0, 3), 1, 0)
+	if got := slices.Collect(views[0]); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Broadcast view = %v, want [0 1 2]", got)
+	}
+	if got := slices.Collect(views[0]); len(got) != 0 {
+		t.Fatalf("Broadcast view iterated again = %v, want empty", got)
+	}
+}
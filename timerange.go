@@ -0,0 +1,77 @@
+package itu
+
+import (
+	"iter"
+	"time"
+)
+
+// TimeRange returns a lazy iterator that counts from start towards end using
+// step, like [RangeBy] for times.
+//
+// For step > 0 it yields the half-open range [start, end) in chronological
+// order; for step < 0 it yields (end, start] in reverse chronological order.
+// Each value is start plus a multiple of step, computed with time.Time.Add, so
+// the monotonic clock reading and location of start are preserved.
+//
+// If step == 0, TimeRange treats it as non-negative: it yields no values when
+// start is not before end, and otherwise produces an infinite sequence of
+// start values until the consumer stops.
+//
+// If the offset from start overflows a time.Duration, the sequence stops (it
+// does not wrap around).
+func TimeRange(start, end time.Time, step time.Duration) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for off, ovf := time.Duration(0), false; !ovf; off, ovf = overflowingAdd(off, step) {
+			t := start.Add(off)
+			if step >= 0 && !t.Before(end) || step < 0 && !t.After(end) {
+				return
+			}
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// DateRange returns a lazy iterator over calendar dates, starting at start and
+// advancing by the given number of years, months and days, as with
+// time.Time.AddDate, while the values stay before end.
+//
+// The i-th value is start.AddDate(i*years, i*months, i*days). Computing it
+// from start rather than from the previous value keeps the day of month
+// stable: monthly steps from January 31, 2025 give January 31, March 3 (the
+// normalized February 31), March 31, and so on. Because AddDate works in the
+// location of start, stepping by days across a daylight saving change keeps
+// the time of day.
+//
+// If the step moves forward in time, DateRange yields the half-open range
+// [start, end); if it moves backward, it yields (end, start] in reverse
+// chronological order. If the step is zero, it yields no values when start is
+// not before end, and otherwise produces an infinite sequence of start values
+// until the consumer stops.
+//
+// If a multiple of the step overflows an int, the sequence stops (it does not
+// wrap around).
+func DateRange(start, end time.Time, years, months, days int) iter.Seq[time.Time] {
+	backward := start.AddDate(years, months, days).Before(start)
+	return func(yield func(time.Time) bool) {
+		var y, m, d int
+		for {
+			t := start.AddDate(y, m, d)
+			if !backward && !t.Before(end) || backward && !t.After(end) {
+				return
+			}
+			if !yield(t) {
+				return
+			}
+
+			var ovfY, ovfM, ovfD bool
+			y, ovfY = overflowingAdd(y, years)
+			m, ovfM = overflowingAdd(m, months)
+			d, ovfD = overflowingAdd(d, days)
+			if ovfY || ovfM || ovfD {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package itu_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleTimeRange() {
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	for t := range itu.TimeRange(start, end, 45*time.Minute) {
+		fmt.Println(t.Format(time.Kitchen))
+	}
+	// Output:
+	// 9:00AM
+	// 9:45AM
+	// 10:30AM
+}
+
+func ExampleDateRange() {
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for d := range itu.DateRange(start, end, 0, 1, 0) {
+		fmt.Println(d.Format(time.DateOnly))
+	}
+	// Output:
+	// 2024-01-15
+	// 2024-02-15
+	// 2024-03-15
+	// 2024-04-15
+}
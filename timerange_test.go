@@ -0,0 +1,98 @@
+package itu
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTimeRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := slices.Collect(TimeRange(start, start.Add(time.Hour), 20*time.Minute))
+	want := []time.Time{start, start.Add(20 * time.Minute), start.Add(40 * time.Minute)}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Fatalf("TimeRange = %v, want %v", got, want)
+	}
+}
+
+func TestTimeRange_Backward(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := slices.Collect(TimeRange(start, start.Add(-time.Hour), -30*time.Minute))
+	want := []time.Time{start, start.Add(-30 * time.Minute)}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Fatalf("TimeRange(backward) = %v, want %v", got, want)
+	}
+	if got := slices.Collect(TimeRange(start, start.Add(time.Hour), -time.Minute)); len(got) != 0 {
+		t.Fatalf("TimeRange(wrong direction) = %v, want empty", got)
+	}
+}
+
+func TestTimeRange_ZeroStep(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := slices.Collect(Take(TimeRange(start, start.Add(time.Second), 0), 2)); len(got) != 2 {
+		t.Fatalf("TimeRange(step=0) = %v, want 2 values", got)
+	}
+	if got := slices.Collect(TimeRange(start, start, 0)); len(got) != 0 {
+		t.Fatalf("TimeRange(start == end, step=0) = %v, want empty", got)
+	}
+}
+
+func TestTimeRange_Overflow(t *testing.T) {
+	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Offsets 0, step and 2*step fit in a Duration; 3*step overflows.
+	step := time.Duration(math.MaxInt64 / 2)
+	if got := Count(TimeRange(start, end, step)); got != 3 {
+		t.Fatalf("TimeRange up to Duration overflow yielded %d values, want 3", got)
+	}
+}
+
+func TestDateRange_Months(t *testing.T) {
+	start := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	var got []string
+	for d := range DateRange(start, end, 0, 1, 0) {
+		got = append(got, d.Format(time.DateOnly))
+	}
+	want := []string{"2025-01-31", "2025-03-03", "2025-03-31", "2025-05-01", "2025-05-31"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("DateRange(monthly) = %v, want %v", got, want)
+	}
+}
+
+func TestDateRange_DaysAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	start := time.Date(2024, 3, 9, 9, 0, 0, 0, loc)
+	for d := range DateRange(start, start.AddDate(0, 0, 3), 0, 0, 1) {
+		if d.Hour() != 9 {
+			t.Fatalf("DateRange across DST yielded %v, want 09:00 local time", d)
+		}
+	}
+}
+
+func TestDateRange_Backward(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	var got []string
+	for d := range DateRange(start, end, 0, -1, 0) {
+		got = append(got, d.Format(time.DateOnly))
+	}
+	want := []string{"2024-03-01", "2024-02-01", "2024-01-01"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("DateRange(backward) = %v, want %v", got, want)
+	}
+}
+
+func TestDateRange_ZeroStep(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := Count(Take(DateRange(start, start.AddDate(1, 0, 0), 0, 0, 0), 3)); got != 3 {
+		t.Fatalf("DateRange(zero step) yielded %d values, want 3", got)
+	}
+	if got := Count(DateRange(start, start, 0, 0, 0)); got != 0 {
+		t.Fatalf("DateRange(start == end, zero step) yielded %d values, want 0", got)
+	}
+}
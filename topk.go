@@ -0,0 +1,142 @@
+package itu
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+	"slices"
+)
+
+// TopK consumes seq eagerly and returns its k largest elements in descending
+// order, using [cmp.Compare] to order them.
+//
+// TopK keeps at most k elements in a heap while scanning seq, so it uses
+// O(k) memory and O(n log k) time instead of sorting the whole input. Equal
+// elements are ranked by their position in seq: the earlier one comes first
+// and is kept in preference to a later one. If seq yields fewer than k
+// elements, all of them are returned.
+//
+// If k is zero, TopK returns nil without consuming seq.
+//
+// TopK panics if k is negative.
+func TopK[E cmp.Ordered](seq iter.Seq[E], k int) []E {
+	if k < 0 {
+		panic("itu: TopK: k must be non-negative")
+	}
+	return selectK(seq, k, cmp.Compare[E])
+}
+
+// TopKFunc is like [TopK] but orders the elements with cmpFn.
+//
+// TopKFunc panics if k is negative or cmpFn is nil.
+func TopKFunc[E any](seq iter.Seq[E], k int, cmpFn func(E, E) int) []E {
+	if k < 0 {
+		panic("itu: TopKFunc: k must be non-negative")
+	}
+	if cmpFn == nil {
+		panic("itu: TopKFunc cmpFn is nil")
+	}
+	return selectK(seq, k, cmpFn)
+}
+
+// BottomK consumes seq eagerly and returns its k smallest elements in
+// ascending order, using [cmp.Compare] to order them.
+//
+// It is the mirror image of [TopK] and has the same complexity and tie-breaking
+// rules.
+//
+// If k is zero, BottomK returns nil without consuming seq.
+//
+// BottomK panics if k is negative.
+func BottomK[E cmp.Ordered](seq iter.Seq[E], k int) []E {
+	if k < 0 {
+		panic("itu: BottomK: k must be non-negative")
+	}
+	return selectK(seq, k, func(a, b E) int { return cmp.Compare(b, a) })
+}
+
+// BottomKFunc is like [BottomK] but orders the elements with cmpFn.
+//
+// BottomKFunc panics if k is negative or cmpFn is nil.
+func BottomKFunc[E any](seq iter.Seq[E], k int, cmpFn func(E, E) int) []E {
+	if k < 0 {
+		panic("itu: BottomKFunc: k must be non-negative")
+	}
+	if cmpFn == nil {
+		panic("itu: BottomKFunc cmpFn is nil")
+	}
+	return selectK(seq, k, func(a, b E) int { return cmpFn(b, a) })
+}
+
+// selectK returns the k greatest elements of seq according to cmpFn, greatest
+// first.
+func selectK[E any](seq iter.Seq[E], k int, cmpFn func(E, E) int) []E {
+	if k == 0 {
+		return nil
+	}
+
+	h := &rankHeap[E]{cmp: cmpFn}
+	idx := 0
+	for v := range seq {
+		item := ranked[E]{val: v, idx: idx}
+		idx++
+		if h.Len() < k {
+			heap.Push(h, item)
+			continue
+		}
+		if h.better(item, h.items[0]) {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+
+	slices.SortFunc(h.items, func(a, b ranked[E]) int {
+		switch {
+		case h.better(a, b):
+			return -1
+		case h.better(b, a):
+			return 1
+		}
+		return 0
+	})
+	out := make([]E, len(h.items))
+	for i, item := range h.items {
+		out[i] = item.val
+	}
+	return out
+}
+
+type ranked[E any] struct {
+	val E
+	idx int
+}
+
+// rankHeap is a min-heap that keeps the worst of the selected elements at the
+// top, so it can be evicted when a better element arrives.
+type rankHeap[E any] struct {
+	items []ranked[E]
+	cmp   func(E, E) int
+}
+
+// better reports whether a ranks strictly higher than b: it compares greater,
+// or compares equal and was yielded earlier.
+func (h *rankHeap[E]) better(a, b ranked[E]) bool {
+	if c := h.cmp(a.val, b.val); c != 0 {
+		return c > 0
+	}
+	return a.idx < b.idx
+}
+
+func (h *rankHeap[E]) Len() int { return len(h.items) }
+
+func (h *rankHeap[E]) Less(i, j int) bool { return h.better(h.items[j], h.items[i]) }
+
+func (h *rankHeap[E]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *rankHeap[E]) Push(x any) { h.items = append(h.items, x.(ranked[E])) }
+
+func (h *rankHeap[E]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
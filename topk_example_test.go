@@ -0,0 +1,46 @@
+package itu_test
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleTopK() {
+	scores := itu.Of(42, 7, 99, 13, 58, 71)
+	fmt.Println(itu.TopK(scores, 3))
+	// Output:
+	// [99 71 58]
+}
+
+func ExampleTopKFunc() {
+	type record struct {
+		ID    string
+		Score float64
+	}
+	records := itu.Of(
+		record{"a", 0.3}, record{"b", 0.9}, record{"c", 0.5}, record{"d", 0.7},
+	)
+	byScore := func(x, y record) int { return cmp.Compare(x.Score, y.Score) }
+	for _, r := range itu.TopKFunc(records, 2, byScore) {
+		fmt.Println(r.ID, r.Score)
+	}
+	// Output:
+	// b 0.9
+	// d 0.7
+}
+
+func ExampleBottomK() {
+	latencies := itu.Of(120, 35, 80, 15, 200)
+	fmt.Println(itu.BottomK(latencies, 2))
+	// Output:
+	// [15 35]
+}
+
+func ExampleBottomKFunc() {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	fmt.Println(itu.BottomKFunc(itu.Of("kiwi", "fig", "banana", "plum"), 2, byLen))
+	// Output:
+	// [fig kiwi]
+}
@@ -0,0 +1,119 @@
+package itu
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestTopK_ReturnsLargestDescending(t *testing.T) {
+	got := TopK(Of(5, 1, 9, 3, 7, 2, 8), 3)
+	want := []int{9, 8, 7}
+	if !slices.Equal(got, want) {
+		t.Fatalf("TopK(_, 3) = %v, want %v", got, want)
+	}
+}
+
+func TestTopK_FewerThanK(t *testing.T) {
+	got := TopK(Of(2, 3, 1), 10)
+	want := []int{3, 2, 1}
+	if !slices.Equal(got, want) {
+		t.Fatalf("TopK([2 3 1], 10) = %v, want %v", got, want)
+	}
+}
+
+func TestTopK_ZeroDoesNotConsume(t *testing.T) {
+	produced := 0
+	seq := func(yield func(int) bool) {
+		produced++
+		yield(1)
+	}
+	if got := TopK(seq, 0); got != nil {
+		t.Fatalf("TopK(_, 0) = %v, want nil", got)
+	}
+	if produced != 0 {
+		t.Fatalf("TopK(_, 0) consumed %d values, want 0", produced)
+	}
+}
+
+func TestTopK_MatchesSort(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = r.IntN(100)
+	}
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b int) int { return cmp.Compare(b, a) })
+
+	for _, k := range []int{1, 5, 100, 1000} {
+		got := TopK(slices.Values(values), k)
+		if !slices.Equal(got, sorted[:k]) {
+			t.Fatalf("TopK(random, %d) = %v, want %v", k, got, sorted[:k])
+		}
+		gotBottom := BottomK(slices.Values(values), k)
+		wantBottom := slices.Clone(sorted[len(sorted)-k:])
+		slices.Reverse(wantBottom)
+		if !slices.Equal(gotBottom, wantBottom) {
+			t.Fatalf("BottomK(random, %d) = %v, want %v", k, gotBottom, wantBottom)
+		}
+	}
+}
+
+type topKScored struct {
+	name  string
+	score int
+}
+
+func TestTopKFunc_TiesKeepEarlierElements(t *testing.T) {
+	items := Of(
+		topKScored{"a", 1}, topKScored{"b", 3}, topKScored{"c", 3},
+		topKScored{"d", 2}, topKScored{"e", 3},
+	)
+	byScore := func(x, y topKScored) int { return cmp.Compare(x.score, y.score) }
+
+	var got []string
+	for _, s := range TopKFunc(items, 2, byScore) {
+		got = append(got, s.name)
+	}
+	if !slices.Equal(got, []string{"b", "c"}) {
+		t.Fatalf("TopKFunc(ties) = %v, want [b c]", got)
+	}
+}
+
+func TestBottomKFunc_TiesKeepEarlierElements(t *testing.T) {
+	items := Of(
+		topKScored{"a", 2}, topKScored{"b", 1}, topKScored{"c", 1},
+		topKScored{"d", 1},
+	)
+	byScore := func(x, y topKScored) int { return cmp.Compare(x.score, y.score) }
+
+	var got []string
+	for _, s := range BottomKFunc(items, 2, byScore) {
+		got = append(got, s.name)
+	}
+	if !slices.Equal(got, []string{"b", "c"}) {
+		t.Fatalf("BottomKFunc(ties) = %v, want [b c]", got)
+	}
+}
+
+func TestTopK_PanicsOnInvalidArgs(t *testing.T) {
+	ops := map[string]func(){
+		"TopK(-1)":         func() { TopK(Of(1), -1) },
+		"TopKFunc(-1)":     func() { TopKFunc(Of(1), -1, cmp.Compare[int]) },
+		"TopKFunc(nil)":    func() { TopKFunc(Of(1), 1, nil) },
+		"BottomK(-1)":      func() { BottomK(Of(1), -1) },
+		"BottomKFunc(-1)":  func() { BottomKFunc(Of(1), -1, cmp.Compare[int]) },
+		"BottomKFunc(nil)": func() { BottomKFunc(Of(1), 1, nil) },
+	}
+	for name, op := range ops {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Fatalf("%s did not panic, want panic", name)
+				}
+			}()
+			op()
+		}()
+	}
+}
@@ -0,0 +1,139 @@
+package itu
+
+import "iter"
+
+// Unzip splits seq into a sequence of its keys and a sequence of its values.
+//
+// The two sequences share a single pass over seq: whichever of them is
+// iterated first drives seq, and the elements meant for the other one are
+// buffered until it catches up. They can be iterated one after the other, or
+// interleaved, as with Zip(keys, values), and seq still runs only once. The
+// buffer grows with the distance between the two consumers; elements are no
+// longer buffered for a sequence whose iteration has ended or was stopped.
+//
+// Once both iterations have finished, the shared pass ends, and iterating
+// either sequence again starts a new one. A sequence iterated again while the
+// other is still in the middle of the shared pass, or from inside its own loop,
+// runs seq on its own.
+//
+// Both sequences must eventually be iterated. If the consumer of one of them
+// stops early, seq stays suspended, holding whatever resources it uses, so that
+// the other one can still yield every remaining element of the same pass; seq
+// is never run again to make up for it. To release seq without consuming the
+// rest, start iterating the other sequence and stop right away:
+//
+//	for range values {
+//		break
+//	}
+//
+// The sequences are not safe for concurrent use from several goroutines.
+func Unzip[K, V any](seq iter.Seq2[K, V]) (iter.Seq[K], iter.Seq[V]) {
+	s := &unzipState[K, V]{seq: seq}
+	keys := unzipSide(s, &s.keys, s.values.done,
+		func(k K, _ V) K { return k },
+		func(k K, v V) { s.values.buf = append(s.values.buf, v) },
+	)
+	values := unzipSide(s, &s.values, s.keys.done,
+		func(_ K, v V) V { return v },
+		func(k K, v V) { s.keys.buf = append(s.keys.buf, k) },
+	)
+	return keys, values
+}
+
+type unzipState[K, V any] struct {
+	seq    iter.Seq2[K, V]
+	next   func() (K, V, bool)
+	stop   func()
+	ended  bool
+	keys   unzipSideState[K]
+	values unzipSideState[V]
+}
+
+const (
+	unzipIdle = iota
+	unzipActive
+	unzipDone
+)
+
+type unzipSideState[T any] struct {
+	state int
+	buf   []T
+}
+
+func (s *unzipSideState[T]) done() bool { return s.state == unzipDone }
+
+// unzipSide returns the sequence of one side of s. self is the state of that
+// side and otherDone reports whether the opposite one is done; pick selects
+// the value of this side from a pair, and keep buffers the value of the
+// opposite side.
+func unzipSide[K, V, T any](s *unzipState[K, V], self *unzipSideState[T], otherDone func() bool, pick func(K, V) T, keep func(K, V)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if self.state != unzipIdle {
+			for k, v := range s.seq {
+				if !yield(pick(k, v)) {
+					return
+				}
+			}
+			return
+		}
+
+		self.state = unzipActive
+		defer func() {
+			self.state = unzipDone
+			self.buf = nil
+			if otherDone() {
+				s.reset()
+			}
+		}()
+
+		for {
+			if len(self.buf) > 0 {
+				v := self.buf[0]
+				var zero T
+				self.buf[0] = zero
+				self.buf = self.buf[1:]
+				if !yield(v) {
+					return
+				}
+				continue
+			}
+
+			k, v, ok := s.pull()
+			if !ok {
+				return
+			}
+			if !otherDone() {
+				keep(k, v)
+			}
+			if !yield(pick(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+func (s *unzipState[K, V]) pull() (K, V, bool) {
+	if s.ended {
+		var k K
+		var v V
+		return k, v, false
+	}
+	if s.next == nil {
+		s.next, s.stop = iter.Pull2(s.seq)
+	}
+	k, v, ok := s.next()
+	if !ok {
+		s.ended = true
+	}
+	return k, v, ok
+}
+
+// reset ends the shared pass once both sides are done with it.
+func (s *unzipState[K, V]) reset() {
+	if s.stop != nil {
+		s.stop()
+	}
+	s.next, s.stop, s.ended = nil, nil, false
+	s.keys = unzipSideState[K]{}
+	s.values = unzipSideState[V]{}
+}
@@ -0,0 +1,18 @@
+package itu_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleUnzip() {
+	pairs := itu.Enumerate(itu.Of("a", "b", "c"))
+	indexes, letters := itu.Unzip(pairs)
+	fmt.Println(slices.Collect(letters))
+	fmt.Println(slices.Collect(indexes))
+	// Output:
+	// [a b c]
+	// [0 1 2]
+}
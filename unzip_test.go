@@ -0,0 +1,157 @@
+package itu
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// countingSeq2 yields (i, i*10) for i in [0, n) and counts its runs.
+func countingSeq2(n int, runs *int) func(func(int, int) bool) {
+	return func(yield func(int, int) bool) {
+		*runs++
+		for i := range n {
+			if !yield(i, i*10) {
+				return
+			}
+		}
+	}
+}
+
+func TestUnzip_Sequential(t *testing.T) {
+	runs := 0
+	keys, values := Unzip(countingSeq2(3, &runs))
+	ks := slices.Collect(keys)
+	vs := slices.Collect(values)
+	if !slices.Equal(ks, []int{0, 1, 2}) || !slices.Equal(vs, []int{0, 10, 20}) {
+		t.Fatalf("Unzip = %v, %v, want [0 1 2], [0 10 20]", ks, vs)
+	}
+	if runs != 1 {
+		t.Fatalf("Unzip ran the source %d times, want 1", runs)
+	}
+}
+
+func TestUnzip_Interleaved(t *testing.T) {
+	runs := 0
+	keys, values := Unzip(countingSeq2(3, &runs))
+	got := collect2(Zip(keys, values))
+	want := []pair[int, int]{{0, 0}, {1, 10}, {2, 20}}
+	if !reflect.DeepEqual(got, want) || runs != 1 {
+		t.Fatalf("Zip(Unzip) = %v after %d runs, want %v after 1", got, runs, want)
+	}
+}
+
+func TestUnzip_NewPassAfterBothDone(t *testing.T) {
+	runs := 0
+	keys, values := Unzip(countingSeq2(3, &runs))
+	Count(values)
+	Count(keys)
+	if got := slices.Collect(keys); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("keys second pass = %v, want [0 1 2]", got)
+	}
+	if got := slices.Collect(values); !slices.Equal(got, []int{0, 10, 20}) {
+		t.Fatalf("values second pass = %v, want [0 10 20]", got)
+	}
+	if runs != 2 {
+		t.Fatalf("Unzip ran the source %d times, want 2", runs)
+	}
+}
+
+func TestUnzip_EarlyStop(t *testing.T) {
+	runs := 0
+	keys, values := Unzip(countingSeq2(5, &runs))
+	if got := slices.Collect(Take(keys, 2)); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("Take(keys, 2) = %v, want [0 1]", got)
+	}
+	if got := slices.Collect(values); !slices.Equal(got, []int{0, 10, 20, 30, 40}) {
+		t.Fatalf("values after partial keys = %v, want [0 10 20 30 40]", got)
+	}
+	if runs != 1 {
+		t.Fatalf("Unzip ran the source %d times, want 1", runs)
+	}
+}
+
+func TestUnzip_RepeatDuringPass(t *testing.T) {
+	runs := 0
+	keys, values := Unzip(countingSeq2(3, &runs))
+	Count(Take(keys, 1))
+	// values has not run yet, so the pass is still open; iterating keys again
+	// has to run the source on its own.
+	if got := slices.Collect(keys); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("keys repeated = %v, want [0 1 2]", got)
+	}
+	if got := slices.Collect(values); !slices.Equal(got, []int{0, 10, 20}) {
+		t.Fatalf("values = %v, want [0 10 20]", got)
+	}
+	if runs != 2 {
+		t.Fatalf("Unzip ran the source %d times, want 2", runs)
+	}
+}
+
+func TestUnzip_StopsSource(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int, int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i, i) {
+				return
+			}
+		}
+	}
+	keys, values := Unzip(seq)
+	Count(Take(keys, 2))
+	if stopped {
+		t.Fatalf("source stopped before values was iterated")
+	}
+	Count(Take(values, 3))
+	if !stopped {
+		t.Fatalf("source not stopped after both sides finished")
+	}
+}
+
+func TestUnzip_Map(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	keys, values := Unzip(maps.All(m))
+	for k, v := range Zip(keys, values) {
+		if m[k] != v {
+			t.Fatalf("Unzip(maps.All) paired %q with %d, want %d", k, v, m[k])
+		}
+	}
+}
+
+func TestUnzip_EarlyStopSinglePass(t *testing.T) {
+	ch := make(chan string, 6)
+	for _, s := range []string{"a", "b", "c", "d", "e", "f"} {
+		ch <- s
+	}
+	close(ch)
+	keys, values := Unzip(Enumerate(FromChan(ch)))
+	if got := slices.Collect(Take(keys, 2)); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("Take(keys, 2) = %v, want [0 1]", got)
+	}
+	want := []string{"a", "b", "c", "d", "e", "f"}
+	if got := slices.Collect(values); !slices.Equal(got, want) {
+		t.Fatalf("values after partial keys = %v, want %v", got, want)
+	}
+}
+
+func TestUnzip_ReleaseByStoppingOtherSide(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int, int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i, i) {
+				return
+			}
+		}
+	}
+	keys, values := Unzip(seq)
+	Count(Take(keys, 2))
+	for range values {
+		break
+	}
+	if !stopped {
+		t.Fatalf("source still suspended after both sides stopped")
+	}
+}
@@ -0,0 +1,162 @@
+package itu
+
+import (
+	"io/fs"
+	"iter"
+	"os"
+	"path"
+)
+
+// WalkOptions configures [WalkDir] and [WalkDirErr]. The zero value, like a
+// nil *WalkOptions, walks the whole tree, does not follow symbolic links and
+// yields every entry.
+type WalkOptions struct {
+	// MaxDepth limits how far below root the walk descends. The root is at
+	// depth 0 and its direct entries are at depth 1. Zero or a negative value
+	// means no limit.
+	MaxDepth int
+
+	// FollowSymlinks makes the walk descend into symbolic links that point to
+	// directories. The link itself is still yielded with its original
+	// fs.DirEntry. Links that would lead back into a directory being walked
+	// are not followed; this check relies on os.SameFile and so only works
+	// for file systems such as os.DirFS. On other file systems, set MaxDepth
+	// to guard against cycles.
+	FollowSymlinks bool
+
+	// Pattern, if not empty, restricts the yielded entries to those whose base
+	// name matches it, using the syntax of path.Match. It does not affect which
+	// directories are descended into.
+	Pattern string
+}
+
+// WalkDir returns a lazy iterator over the file tree rooted at root in fsys,
+// yielding a pair (path, entry) for each file or directory, root included.
+//
+// The tree is walked in the same lexical, depth-first order as fs.WalkDir, and
+// paths are formed the same way. Unlike fs.WalkDir, the walk can be stopped by
+// breaking out of the range loop and composes with the Seq2 adapters such as
+// [Filter2], [Take2] and [Find2]. Directories are read only as the iterator is
+// consumed.
+//
+// WalkDir skips entries it cannot read, such as directories without read
+// permission, and yields nothing if root cannot be opened. Use [WalkDirErr] to
+// observe such errors. A nil opts is the same as a zero WalkOptions.
+//
+// WalkDir panics if opts.Pattern is malformed.
+func WalkDir(fsys fs.FS, root string, opts *WalkOptions) iter.Seq2[string, fs.DirEntry] {
+	o := walkOptions(opts, "WalkDir")
+	return func(yield func(string, fs.DirEntry) bool) {
+		w := walker{fsys: fsys, opts: o, yield: yield, onErr: func(error) bool { return true }}
+		w.walkRoot(root)
+	}
+}
+
+// WalkDirErr is like [WalkDir] but stops at the first error instead of
+// skipping the entry that caused it. It returns the sequence together with a
+// function reporting the error that ended the most recent iteration, or nil if
+// it ended for any other reason, like bufio.Scanner.Err.
+//
+// WalkDirErr panics if opts.Pattern is malformed.
+func WalkDirErr(fsys fs.FS, root string, opts *WalkOptions) (iter.Seq2[string, fs.DirEntry], func() error) {
+	o := walkOptions(opts, "WalkDirErr")
+	var failure error
+	seq := func(yield func(string, fs.DirEntry) bool) {
+		failure = nil
+		w := walker{fsys: fsys, opts: o, yield: yield, onErr: func(err error) bool {
+			failure = err
+			return false
+		}}
+		w.walkRoot(root)
+	}
+	return seq, func() error { return failure }
+}
+
+func walkOptions(opts *WalkOptions, fn string) WalkOptions {
+	if opts == nil {
+		return WalkOptions{}
+	}
+	if _, err := path.Match(opts.Pattern, ""); err != nil {
+		panic("itu: " + fn + ": malformed Pattern")
+	}
+	return *opts
+}
+
+type walker struct {
+	fsys  fs.FS
+	opts  WalkOptions
+	yield func(string, fs.DirEntry) bool
+	// onErr handles a walk error and reports whether the walk should go on.
+	onErr func(error) bool
+	// ancestors holds the directories being walked when following symlinks.
+	ancestors []fs.FileInfo
+}
+
+func (w *walker) walkRoot(root string) {
+	info, err := fs.Stat(w.fsys, root)
+	if err != nil {
+		w.onErr(err)
+		return
+	}
+	w.walk(root, fs.FileInfoToDirEntry(info), 0)
+}
+
+// walk visits p and, if it is a directory, its contents. It reports whether
+// the walk should go on.
+func (w *walker) walk(p string, d fs.DirEntry, depth int) bool {
+	if w.opts.Pattern == "" || w.matches(d.Name()) {
+		if !w.yield(p, d) {
+			return false
+		}
+	}
+
+	if w.opts.MaxDepth > 0 && depth >= w.opts.MaxDepth {
+		return true
+	}
+
+	isDir := d.IsDir()
+	var info fs.FileInfo
+	if w.opts.FollowSymlinks {
+		var err error
+		if d.Type()&fs.ModeSymlink != 0 {
+			info, err = fs.Stat(w.fsys, p)
+		} else if isDir {
+			info, err = d.Info()
+		}
+		if err != nil {
+			return w.onErr(err)
+		}
+		if info != nil && info.IsDir() {
+			for _, a := range w.ancestors {
+				if os.SameFile(a, info) {
+					return true
+				}
+			}
+			isDir = true
+		}
+	}
+	if !isDir {
+		return true
+	}
+
+	entries, err := fs.ReadDir(w.fsys, p)
+	if err != nil {
+		return w.onErr(err)
+	}
+
+	if info != nil {
+		w.ancestors = append(w.ancestors, info)
+		defer func() { w.ancestors = w.ancestors[:len(w.ancestors)-1] }()
+	}
+	for _, e := range entries {
+		if !w.walk(path.Join(p, e.Name()), e, depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *walker) matches(name string) bool {
+	ok, _ := path.Match(w.opts.Pattern, name)
+	return ok
+}
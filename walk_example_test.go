@@ -0,0 +1,40 @@
+package itu_test
+
+import (
+	"fmt"
+	"io/fs"
+	"testing/fstest"
+
+	"github.com/lymar/itu"
+)
+
+func ExampleWalkDir() {
+	fsys := fstest.MapFS{
+		"go.mod":                 {},
+		"cmd/app/main.go":        {},
+		"internal/db/db.go":      {},
+		"internal/db/db_test.go": {},
+		"README.md":              {},
+	}
+	files := itu.Filter2(
+		itu.WalkDir(fsys, ".", &itu.WalkOptions{Pattern: "*.go"}),
+		func(_ string, d fs.DirEntry) bool { return !d.IsDir() },
+	)
+	for p := range itu.Take2(files, 2) {
+		fmt.Println(p)
+	}
+	// Output:
+	// cmd/app/main.go
+	// internal/db/db.go
+}
+
+func ExampleWalkDirErr() {
+	fsys := fstest.MapFS{"a/b/c.txt": {}}
+	seq, errf := itu.WalkDirErr(fsys, "missing", nil)
+	for p := range seq {
+		fmt.Println(p)
+	}
+	fmt.Println(errf() != nil)
+	// Output:
+	// true
+}
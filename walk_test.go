@@ -0,0 +1,209 @@
+package itu
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+var walkFS = fstest.MapFS{
+	"a.txt":         {Data: []byte("a")},
+	"b/c.go":        {Data: []byte("c")},
+	"b/d/e.go":      {Data: []byte("e")},
+	"b/d/f.txt":     {Data: []byte("f")},
+	"g/h/i/j.go":    {Data: []byte("j")},
+	"g/h/i/k/l.txt": {Data: []byte("l")},
+}
+
+func walkPaths(seq func(func(string, fs.DirEntry) bool)) []string {
+	var paths []string
+	for p := range seq {
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+func TestWalkDir_MatchesFSWalkDir(t *testing.T) {
+	var want []string
+	fs.WalkDir(walkFS, ".", func(p string, d fs.DirEntry, err error) error {
+		want = append(want, p)
+		return err
+	})
+	if got := walkPaths(WalkDir(walkFS, ".", nil)); !slices.Equal(got, want) {
+		t.Fatalf("WalkDir = %v, want %v", got, want)
+	}
+}
+
+func TestWalkDir_SubtreeRoot(t *testing.T) {
+	got := walkPaths(WalkDir(walkFS, "b/d", nil))
+	want := []string{"b/d", "b/d/e.go", "b/d/f.txt"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("WalkDir(b/d) = %v, want %v", got, want)
+	}
+}
+
+func TestWalkDir_MaxDepth(t *testing.T) {
+	got := walkPaths(WalkDir(walkFS, ".", &WalkOptions{MaxDepth: 2}))
+	want := []string{".", "a.txt", "b", "b/c.go", "b/d", "g", "g/h"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("WalkDir(MaxDepth 2) = %v, want %v", got, want)
+	}
+}
+
+func TestWalkDir_Pattern(t *testing.T) {
+	got := walkPaths(WalkDir(walkFS, ".", &WalkOptions{Pattern: "*.go"}))
+	want := []string{"b/c.go", "b/d/e.go", "g/h/i/j.go"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("WalkDir(*.go) = %v, want %v", got, want)
+	}
+}
+
+func TestWalkDir_MalformedPatternPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("WalkDir with malformed pattern did not panic")
+		}
+	}()
+	WalkDir(walkFS, ".", &WalkOptions{Pattern: "[a"})
+}
+
+func TestWalkDir_StopsReadingAfterBreak(t *testing.T) {
+	var opened []string
+	fsys := openRecorder{walkFS, &opened}
+	_, _, ok := Find2(WalkDir(fsys, ".", nil), func(p string, d fs.DirEntry) bool {
+		return p == "b/c.go"
+	})
+	if !ok {
+		t.Fatalf("Find2(WalkDir) did not find b/c.go")
+	}
+	if want := []string{".", "b"}; !slices.Equal(opened, want) {
+		t.Fatalf("WalkDir read directories %v, want %v", opened, want)
+	}
+}
+
+func TestWalkDir_MissingRoot(t *testing.T) {
+	if got := walkPaths(WalkDir(walkFS, "nope", nil)); len(got) != 0 {
+		t.Fatalf("WalkDir(missing) = %v, want empty", got)
+	}
+	seq, errf := WalkDirErr(walkFS, "nope", nil)
+	if got := walkPaths(seq); len(got) != 0 {
+		t.Fatalf("WalkDirErr(missing) = %v, want empty", got)
+	}
+	if err := errf(); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("WalkDirErr(missing) error = %v, want %v", err, fs.ErrNotExist)
+	}
+}
+
+func TestWalkDir_UnreadableDirectory(t *testing.T) {
+	fsys := failingReadDirFS{walkFS, "b"}
+	got := walkPaths(WalkDir(fsys, ".", nil))
+	want := []string{".", "a.txt", "b", "g", "g/h", "g/h/i", "g/h/i/j.go", "g/h/i/k", "g/h/i/k/l.txt"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("WalkDir(unreadable b) = %v, want %v", got, want)
+	}
+
+	seq, errf := WalkDirErr(fsys, ".", nil)
+	got = walkPaths(seq)
+	if want := []string{".", "a.txt", "b"}; !slices.Equal(got, want) {
+		t.Fatalf("WalkDirErr(unreadable b) = %v, want %v", got, want)
+	}
+	if err := errf(); !errors.Is(err, errBoom) {
+		t.Fatalf("WalkDirErr(unreadable b) error = %v, want %v", err, errBoom)
+	}
+}
+
+func TestWalkDirErr_ResetsError(t *testing.T) {
+	fsys := &toggleFS{MapFS: walkFS}
+	seq, errf := WalkDirErr(fsys, ".", nil)
+	fsys.fail = true
+	walkPaths(seq)
+	if errf() == nil {
+		t.Fatalf("WalkDirErr error = nil, want error")
+	}
+	fsys.fail = false
+	walkPaths(seq)
+	if err := errf(); err != nil {
+		t.Fatalf("WalkDirErr error after clean walk = %v, want nil", err)
+	}
+}
+
+func TestWalkDir_Symlinks(t *testing.T) {
+	dir := t.TempDir()
+	mustMkdir(t, filepath.Join(dir, "real", "sub"))
+	mustWrite(t, filepath.Join(dir, "real", "sub", "x.txt"))
+	mustSymlink(t, filepath.Join("real", "sub"), filepath.Join(dir, "link"))
+	// A link back to an ancestor must not be followed forever.
+	mustSymlink(t, "..", filepath.Join(dir, "real", "loop"))
+	fsys := os.DirFS(dir)
+
+	got := walkPaths(WalkDir(fsys, ".", nil))
+	want := []string{".", "link", "real", "real/loop", "real/sub", "real/sub/x.txt"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("WalkDir(no follow) = %v, want %v", got, want)
+	}
+
+	got = walkPaths(WalkDir(fsys, ".", &WalkOptions{FollowSymlinks: true}))
+	want = []string{".", "link", "link/x.txt", "real", "real/loop", "real/sub", "real/sub/x.txt"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("WalkDir(follow) = %v, want %v", got, want)
+	}
+}
+
+type openRecorder struct {
+	fstest.MapFS
+	opened *[]string
+}
+
+func (f openRecorder) ReadDir(name string) ([]fs.DirEntry, error) {
+	*f.opened = append(*f.opened, name)
+	return f.MapFS.ReadDir(name)
+}
+
+type failingReadDirFS struct {
+	fstest.MapFS
+	dir string
+}
+
+func (f failingReadDirFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == f.dir {
+		return nil, errBoom
+	}
+	return f.MapFS.ReadDir(name)
+}
+
+type toggleFS struct {
+	fstest.MapFS
+	fail bool
+}
+
+func (f *toggleFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if f.fail {
+		return nil, errBoom
+	}
+	return f.MapFS.ReadDir(name)
+}
+
+func mustMkdir(t *testing.T, dir string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustWrite(t *testing.T, name string) {
+	t.Helper()
+	if err := os.WriteFile(name, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustSymlink(t *testing.T, target, link string) {
+	t.Helper()
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+}
@@ -30,3 +30,151 @@ func Zip[T1, T2 any](seq1 iter.Seq[T1], seq2 iter.Seq[T2]) iter.Seq2[T1, T2] {
 		}
 	}
 }
+
+// ZipLongest returns a lazy iterator that yields pairs (a, b) from seq1 and
+// seq2 until both sequences run out of values. Once the shorter sequence has
+// ended, fill1 or fill2 stands in for its missing values.
+//
+// To tell fill values from real ones, use [ZipLongestFunc].
+func ZipLongest[T1, T2 any](seq1 iter.Seq[T1], seq2 iter.Seq[T2], fill1 T1, fill2 T2) iter.Seq2[T1, T2] {
+	return func(yield func(T1, T2) bool) {
+		for v1, v2 := range zipLongest(seq1, seq2) {
+			a, b := fill1, fill2
+			if v1.ok {
+				a = v1.v
+			}
+			if v2.ok {
+				b = v2.v
+			}
+			if !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
+// ZipLongestFunc returns a lazy iterator that yields fn(v1, ok1, v2, ok2) for
+// each position until both seq1 and seq2 run out of values. ok1 and ok2 report
+// whether the corresponding sequence still had a value at that position; if
+// not, the value passed for it is the zero value.
+//
+// ZipLongestFunc panics if fn is nil.
+func ZipLongestFunc[T1, T2, R any](seq1 iter.Seq[T1], seq2 iter.Seq[T2], fn func(v1 T1, ok1 bool, v2 T2, ok2 bool) R) iter.Seq[R] {
+	if fn == nil {
+		panic("itu: ZipLongestFunc fn is nil")
+	}
+	return func(yield func(R) bool) {
+		for v1, v2 := range zipLongest(seq1, seq2) {
+			if !yield(fn(v1.v, v1.ok, v2.v, v2.ok)) {
+				return
+			}
+		}
+	}
+}
+
+type maybe[T any] struct {
+	v  T
+	ok bool
+}
+
+func zipLongest[T1, T2 any](seq1 iter.Seq[T1], seq2 iter.Seq[T2]) iter.Seq2[maybe[T1], maybe[T2]] {
+	return func(yield func(maybe[T1], maybe[T2]) bool) {
+		next1, stop1 := iter.Pull(seq1)
+		defer stop1()
+
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+
+		for {
+			var m1 maybe[T1]
+			var m2 maybe[T2]
+			m1.v, m1.ok = next1()
+			m2.v, m2.ok = next2()
+			if !m1.ok && !m2.ok {
+				return
+			}
+			if !yield(m1, m2) {
+				return
+			}
+		}
+	}
+}
+
+// ZipWith returns a lazy iterator that yields fn(a, b) for the pairs (a, b)
+// from seq1 and seq2. Like [Zip], it stops as soon as either sequence runs out
+// of values.
+//
+// ZipWith panics if fn is nil.
+func ZipWith[T1, T2, R any](seq1 iter.Seq[T1], seq2 iter.Seq[T2], fn func(T1, T2) R) iter.Seq[R] {
+	if fn == nil {
+		panic("itu: ZipWith fn is nil")
+	}
+	return func(yield func(R) bool) {
+		for a, b := range Zip(seq1, seq2) {
+			if !yield(fn(a, b)) {
+				return
+			}
+		}
+	}
+}
+
+// Zip3 returns a lazy iterator that yields fn(a, b, c) for the triples
+// (a, b, c) taken from seq1, seq2 and seq3 in lockstep. It stops as soon as any
+// of the sequences runs out of values. Since there is no three-valued
+// iter.Seq, fn combines each triple into a single value.
+//
+// Zip3 panics if fn is nil.
+func Zip3[T1, T2, T3, R any](seq1 iter.Seq[T1], seq2 iter.Seq[T2], seq3 iter.Seq[T3], fn func(T1, T2, T3) R) iter.Seq[R] {
+	if fn == nil {
+		panic("itu: Zip3 fn is nil")
+	}
+	return func(yield func(R) bool) {
+		next3, stop3 := iter.Pull(seq3)
+		defer stop3()
+
+		for a, b := range Zip(seq1, seq2) {
+			c, ok := next3()
+			if !ok {
+				return
+			}
+			if !yield(fn(a, b, c)) {
+				return
+			}
+		}
+	}
+}
+
+// ZipN returns a lazy iterator that yields, for each position, a slice holding
+// the value of every sequence in seqs at that position, in the order of seqs.
+// It stops as soon as any of the sequences runs out of values. If seqs is
+// empty, ZipN yields nothing.
+//
+// Each yielded slice is newly allocated and may be retained by the caller.
+func ZipN[T any](seqs ...iter.Seq[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+
+		nexts := make([]func() (T, bool), len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			nexts[i] = next
+		}
+
+		for {
+			row := make([]T, len(nexts))
+			for i, next := range nexts {
+				v, ok := next()
+				if !ok {
+					return
+				}
+				row[i] = v
+			}
+			if !yield(row) {
+				return
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package itu_test
 
 import (
 	"fmt"
+	"iter"
 	"slices"
 
 	"github.com/lymar/itu"
@@ -18,3 +19,65 @@ func ExampleZip() {
 	// 1:a
 	// 2:b
 }
+
+func ExampleZipLongest() {
+	names := itu.Of("ann", "bob", "cid")
+	scores := itu.Of(90, 85)
+	for name, score := range itu.ZipLongest(names, scores, "", -1) {
+		fmt.Println(name, score)
+	}
+	// Output:
+	// ann 90
+	// bob 85
+	// cid -1
+}
+
+func ExampleZipLongestFunc() {
+	old := itu.Of("a", "b")
+	cur := itu.Of("a", "x", "c")
+	diff := itu.ZipLongestFunc(old, cur, func(o string, okO bool, c string, okC bool) string {
+		switch {
+		case !okO:
+			return "+" + c
+		case !okC:
+			return "-" + o
+		case o != c:
+			return o + "->" + c
+		}
+		return "=" + c
+	})
+	fmt.Println(slices.Collect(diff))
+	// Output:
+	// [=a b->x +c]
+}
+
+func ExampleZipWith() {
+	prices := itu.Of(2.5, 4.0)
+	quantities := itu.Of(4, 3)
+	totals := itu.ZipWith(prices, quantities, func(p float64, q int) float64 {
+		return p * float64(q)
+	})
+	fmt.Println(slices.Collect(totals))
+	// Output:
+	// [10 12]
+}
+
+func ExampleZip3() {
+	xs := itu.Of(1, 2)
+	ys := itu.Of(3, 4)
+	zs := itu.Of(5, 6)
+	sums := itu.Zip3(xs, ys, zs, func(x, y, z int) int { return x + y + z })
+	fmt.Println(slices.Collect(sums))
+	// Output:
+	// [9 12]
+}
+
+func ExampleZipN() {
+	columns := []iter.Seq[int]{itu.Of(1, 2), itu.Of(3, 4), itu.Of(5, 6)}
+	for row := range itu.ZipN(columns...) {
+		fmt.Println(row)
+	}
+	// Output:
+	// [1 3 5]
+	// [2 4 6]
+}
@@ -1,6 +1,7 @@
 package itu
 
 import (
+	"fmt"
 	"reflect"
 	"slices"
 	"testing"
@@ -50,3 +51,94 @@ func TestZip_InfiniteWithFinite(t *testing.T) {
 		t.Fatalf("Zip(infinite, finite) = %v, want %v", got, want)
 	}
 }
+
+func TestZipLongest(t *testing.T) {
+	got := collect2(ZipLongest(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a"}), -1, "?"))
+	want := []pair[int, string]{{1, "a"}, {2, "?"}, {3, "?"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZipLongest(first longer) = %v, want %v", got, want)
+	}
+
+	got = collect2(ZipLongest(slices.Values([]int{1}), slices.Values([]string{"a", "b"}), -1, "?"))
+	want = []pair[int, string]{{1, "a"}, {-1, "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZipLongest(second longer) = %v, want %v", got, want)
+	}
+
+	if got := collect2(ZipLongest(Empty[int](), Empty[string](), 0, "")); len(got) != 0 {
+		t.Fatalf("ZipLongest(empty, empty) = %v, want empty", got)
+	}
+}
+
+func TestZipLongestFunc(t *testing.T) {
+	seq := ZipLongestFunc(slices.Values([]int{0, 1}), slices.Values([]int{0}),
+		func(a int, okA bool, b int, okB bool) string {
+			return fmt.Sprint(a, okA, b, okB)
+		})
+	got := slices.Collect(seq)
+	want := []string{"0 true 0 true", "1 true 0 false"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("ZipLongestFunc = %q, want %q", got, want)
+	}
+}
+
+func TestZipLongest_EarlyStop(t *testing.T) {
+	got := collect2(Take2(ZipLongest(RangeFrom(0), slices.Values([]int{7}), 0, 0), 3))
+	want := []pair[int, int]{{0, 7}, {1, 0}, {2, 0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Take2(ZipLongest(infinite, short), 3) = %v, want %v", got, want)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	got := slices.Collect(ZipWith(slices.Values([]int{1, 2, 3}), slices.Values([]int{10, 20}),
+		func(a, b int) int { return a + b }))
+	if !slices.Equal(got, []int{11, 22}) {
+		t.Fatalf("ZipWith = %v, want [11 22]", got)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	got := slices.Collect(Zip3(
+		slices.Values([]int{1, 2, 3}),
+		slices.Values([]string{"a", "b", "c"}),
+		slices.Values([]bool{true, false}),
+		func(n int, s string, b bool) string { return fmt.Sprintf("%d %s %t", n, s, b) },
+	))
+	if want := []string{"1 a true", "2 b false"}; !slices.Equal(got, want) {
+		t.Fatalf("Zip3 = %q, want %q", got, want)
+	}
+}
+
+func TestZipN(t *testing.T) {
+	got := slices.Collect(ZipN(
+		slices.Values([]int{1, 2, 3}),
+		slices.Values([]int{4, 5, 6}),
+		slices.Values([]int{7, 8}),
+	))
+	want := [][]int{{1, 4, 7}, {2, 5, 8}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZipN = %v, want %v", got, want)
+	}
+	if got := slices.Collect(ZipN[int]()); len(got) != 0 {
+		t.Fatalf("ZipN() = %v, want empty", got)
+	}
+}
+
+func TestZipN_StopsInputs(t *testing.T) {
+	stopped := 0
+	infinite := func(yield func(int) bool) {
+		defer func() { stopped++ }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	for range ZipN(infinite, infinite) {
+		break
+	}
+	if stopped != 2 {
+		t.Fatalf("ZipN early stop: %d inputs stopped, want 2", stopped)
+	}
+}